@@ -15,24 +15,24 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/knapsack": {
+        "/a-star": {
             "post": {
-                "description": "Computes the solutions for the specified Knapsack problem instance, handling both the Binary and Fractional variants of the problem.",
+                "description": "Computes the cheapest path between two points, using the A* search algorithm. The problem can be given either as a weighted 2D grid or as a graph whose nodes have coordinates.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Solves Knapsack problem",
+                "summary": "Solves A* pathfinding problem",
                 "parameters": [
                     {
-                        "description": "` + "`" + `values` + "`" + ` represents the list of values of each object, ` + "`" + `weights` + "`" + ` represents the list of weights of each object, ` + "`" + `capacity` + "`" + ` represents the maximum weight the knapsack can hold.",
+                        "description": "For grids: ` + "`" + `grid` + "`" + ` represents the cost of entering each cell (negative values mark walls), ` + "`" + `start` + "`" + ` and ` + "`" + `goal` + "`" + ` represent the [row, col] cells to connect, ` + "`" + `connectivity` + "`" + ` represents the number of allowed move directions (4 or 8). For graphs: ` + "`" + `n` + "`" + ` represents the number of nodes (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in a [start, end, weight] format, where nodes are either integers or string names, optionally followed by a direction marker (` + "`" + `-\u003e` + "`" + `, ` + "`" + `\u003c-` + "`" + ` or ` + "`" + `\u003c-\u003e` + "`" + `), ` + "`" + `directed` + "`" + ` represents whether unmarked edges are directed (true by default), ` + "`" + `coordinates` + "`" + ` represents the [x, y] position of each node (as an object keyed by name for named nodes), ` + "`" + `source` + "`" + ` and ` + "`" + `target` + "`" + ` represent the nodes to connect. In both cases, ` + "`" + `heuristic` + "`" + ` represents the distance estimate to use (` + "`" + `manhattan` + "`" + `, ` + "`" + `euclidean` + "`" + ` or ` + "`" + `octile` + "`" + `).",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.HandleKnapsack.requestBody"
+                            "$ref": "#/definitions/handlers.HandleAStar.requestBody"
                         }
                     }
                 ],
@@ -40,7 +40,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/solvers.KnapsackResult"
+                            "$ref": "#/definitions/solvers.AStarResult"
                         }
                     },
                     "400": {
@@ -52,24 +52,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/n-queens": {
+        "/all-pairs-shortest-path": {
             "post": {
-                "description": "Computes the solution for the specified N Queens problem instance, using the Forward Checking algorithm with MRV sorting.",
+                "description": "Computes the distances between every pair of nodes in the specified graph, using the Floyd-Warshall algorithm for dense graphs and Johnson's algorithm for sparse ones. Negative weights are accepted, as long as the graph contains no negative cycles.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Solves N Queens problem",
+                "summary": "Solves All-Pairs Shortest Path problem",
                 "parameters": [
                     {
-                        "description": "` + "`" + `n` + "`" + ` represents the number of queens (one for each row/column), ` + "`" + `blocked` + "`" + ` represents the blocked squares on the chessboard.",
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, optionally followed by a direction marker (` + "`" + `-\u003e` + "`" + `, ` + "`" + `\u003c-` + "`" + ` or ` + "`" + `\u003c-\u003e` + "`" + `), ` + "`" + `directed` + "`" + ` represents whether unmarked edges are directed (true by default), ` + "`" + `algorithm` + "`" + ` optionally forces the algorithm to use (` + "`" + `floyd-warshall` + "`" + ` or ` + "`" + `johnson` + "`" + `).",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.HandleNQueens.requestBody"
+                            "$ref": "#/definitions/handlers.HandleAllPairsShortestPath.requestBody"
                         }
                     }
                 ],
@@ -77,7 +77,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/solvers.NQueensResult"
+                            "$ref": "#/definitions/solvers.AllPairsShortestPathResult"
                         }
                     },
                     "400": {
@@ -89,24 +89,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/shortest-path": {
+        "/assignment": {
             "post": {
-                "description": "Computes the solution for the specified single-source Shortest Path problem instance, using Dijkstra's algorithm.",
+                "description": "Assigns workers to tasks using the Hungarian algorithm, so that the total cost is minimized (or maximized). The cost matrix can be rectangular, in which case some workers or tasks remain unassigned. If edges are given instead of a cost matrix, a minimum-cost flow is computed over the graph.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Solves Shortest Path problem",
+                "summary": "Solves Assignment problem",
                 "parameters": [
                     {
-                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph, ` + "`" + `edges` + "`" + ` represents the directed edges in the graph in a [start, end, weight] format, ` + "`" + `source` + "`" + ` represents the source node from which all paths will be calculated.",
+                        "description": "` + "`" + `costs` + "`" + ` represents the cost of assigning each worker (row) to each task (column), ` + "`" + `objective` + "`" + ` represents whether the total cost is minimized (` + "`" + `minimize` + "`" + ` by default) or maximized (` + "`" + `maximize` + "`" + `). For a minimum-cost flow, ` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, [capacity, cost]] format, where nodes are either integers or string names, optionally followed by a direction marker (` + "`" + `-\u003e` + "`" + `, ` + "`" + `\u003c-` + "`" + ` or ` + "`" + `\u003c-\u003e` + "`" + `), ` + "`" + `directed` + "`" + ` represents whether unmarked edges are directed (true by default), ` + "`" + `source` + "`" + ` and ` + "`" + `sink` + "`" + ` represent the nodes the flow leaves from and arrives at, ` + "`" + `demand` + "`" + ` optionally represents the amount of flow to send (as much as possible by default).",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handlers.HandleShortestPath.requestBody"
+                            "$ref": "#/definitions/handlers.HandleAssignment.requestBody"
                         }
                     }
                 ],
@@ -114,7 +114,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/solvers.ShortestPathResult"
+                            "$ref": "#/definitions/solvers.AssignmentResult"
                         }
                     },
                     "400": {
@@ -126,192 +126,1643 @@ const docTemplate = `{
                 }
             }
         },
-        "/status": {
-            "get": {
-                "description": "Used for testing purposes, when the user wants to check whether the server is currently running.",
+        "/bipartite-matching": {
+            "post": {
+                "description": "Computes a maximum-cardinality matching of the specified bipartite graph with the Hopcroft-Karp algorithm, or a matching of maximum total weight. If the graph is not bipartite, a cycle of odd length is returned as proof.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Returns the health status of the server",
+                "summary": "Solves Bipartite Matching problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, ` + "`" + `left` + "`" + ` and ` + "`" + `right` + "`" + ` optionally represent nodes that must be placed on that side (the sides of the other nodes are inferred), ` + "`" + `mode` + "`" + ` represents what is maximized (` + "`" + `cardinality` + "`" + ` by default, or ` + "`" + `weighted` + "`" + ` for the total weight).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleBipartiteMatching.requestBody"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handlers.HandleStatus.StatusResponse"
+                            "$ref": "#/definitions/solvers.BipartiteMatchingResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.HandleKnapsack.requestBody": {
-            "type": "object",
-            "properties": {
-                "capacity": {
-                    "type": "integer"
-                },
-                "values": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
+        },
+        "/csp": {
+            "post": {
+                "description": "Computes an assignment for the declared variables that satisfies every declared constraint, using the Forward Checking algorithm with MRV sorting. Supported constraints are all-different, linear-sum, table and not-equal-offset.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves constraint satisfaction problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `variables` + "`" + ` represents the variables with their ` + "`" + `domain` + "`" + ` (or inclusive ` + "`" + `range` + "`" + `), ` + "`" + `constraints` + "`" + ` represents the constraints over the variables by name. ` + "`" + `preset` + "`" + ` declares the variables and constraints of a known problem (` + "`" + `n-queens` + "`" + `, using ` + "`" + `n` + "`" + ` and ` + "`" + `blocked` + "`" + `), to which the declared ones are added.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleConstraintSatisfaction.requestBody"
+                        }
                     }
-                },
-                "weights": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.CSPResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "handlers.HandleNQueens.requestBody": {
-            "type": "object",
-            "properties": {
-                "blocked": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
+        "/graph-analysis": {
+            "post": {
+                "description": "Computes the strongly connected components of the specified graph with Tarjan's algorithm, along with the condensation DAG, a topological order (or a cycle that prevents one), the bridges and articulation points, and connectivity statistics.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Analyses the structure of a graph",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, optionally followed by a direction marker (` + "`" + `-\u003e` + "`" + `, ` + "`" + `\u003c-` + "`" + ` or ` + "`" + `\u003c-\u003e` + "`" + `), ` + "`" + `directed` + "`" + ` represents whether unmarked edges are directed (true by default).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleGraphAnalysis.requestBody"
                         }
                     }
-                },
-                "n": {
-                    "type": "integer"
-                }
-            }
-        },
-        "handlers.HandleShortestPath.requestBody": {
-            "type": "object",
-            "properties": {
-                "edges": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.GraphAnalysisResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
                         }
                     }
-                },
-                "n": {
-                    "type": "integer"
-                },
-                "source": {
-                    "type": "integer"
                 }
             }
         },
-        "handlers.HandleStatus.StatusResponse": {
-            "type": "object",
-            "properties": {
-                "status": {
-                    "type": "string"
+        "/graph-coloring": {
+            "post": {
+                "description": "Colors the nodes of the specified graph so that no two adjacent nodes share a color, using at most k colors or, if k is omitted, as few colors as possible. The Forward Checking algorithm with MRV sorting is used by default, while DSatur gives a faster greedy coloring.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Graph Coloring problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names and the weights are ignored, ` + "`" + `k` + "`" + ` optionally represents the number of available colors (the chromatic number is searched for if omitted), ` + "`" + `algorithm` + "`" + ` represents the algorithm to use (` + "`" + `forward-checking` + "`" + ` by default, or ` + "`" + `dsatur` + "`" + `).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleGraphColoring.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.GraphColoringResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "solvers.KnapsackResult": {
-            "type": "object",
-            "properties": {
-                "binary_solution": {
-                    "$ref": "#/definitions/solvers.KnapsackResultData-int"
-                },
-                "formatted_output": {
-                    "type": "string"
-                },
-                "fractional_solution": {
-                    "$ref": "#/definitions/solvers.KnapsackResultData-float64"
-                },
-                "message": {
-                    "type": "string"
+        "/knapsack": {
+            "post": {
+                "description": "Computes the solutions for the specified Knapsack problem instance, handling both the Binary and Fractional variants of the problem. Items can have several copies (bounded) or an unlimited number of them (unbounded), which the Binary variant handles by splitting the copies into bundles of powers of two.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Knapsack problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `values` + "`" + ` represents the list of values of each object, ` + "`" + `weights` + "`" + ` represents the list of weights of each object, ` + "`" + `capacity` + "`" + ` represents the maximum weight the knapsack can hold, ` + "`" + `quantities` + "`" + ` optionally represents the number of copies of each object (1 by default, or when null), ` + "`" + `unlimited` + "`" + ` optionally marks the objects with an unbounded number of copies.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleKnapsack.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.KnapsackResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "solvers.KnapsackResultData-float64": {
-            "type": "object",
-            "properties": {
-                "max_value": {
-                    "type": "number"
-                },
-                "max_weight": {
-                    "type": "number"
-                },
-                "selected_items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/solvers.KnapsackResultItem-float64"
+        "/max-flow": {
+            "post": {
+                "description": "Computes the maximum flow from the source node to the sink node of the specified capacity network, using Dinic's algorithm or the push-relabel algorithm, along with the flow on each edge and a minimum cut.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Maximum Flow problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, capacity] format, where nodes are either integers or string names, optionally followed by a direction marker (` + "`" + `-\u003e` + "`" + `, ` + "`" + `\u003c-` + "`" + ` or ` + "`" + `\u003c-\u003e` + "`" + `), ` + "`" + `directed` + "`" + ` represents whether unmarked edges are directed (true by default), ` + "`" + `source` + "`" + ` represents the node the flow leaves from, ` + "`" + `sink` + "`" + ` represents the node the flow arrives at, ` + "`" + `algorithm` + "`" + ` represents the algorithm to use (` + "`" + `dinic` + "`" + ` by default, or ` + "`" + `push-relabel` + "`" + `).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleMaxFlow.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.MaxFlowResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "solvers.KnapsackResultData-int": {
-            "type": "object",
+        "/minimum-spanning-tree": {
+            "post": {
+                "description": "Computes the minimum spanning tree of the specified undirected graph, using Kruskal's or Prim's algorithm. For disconnected graphs, a minimum spanning forest is returned, along with the total weight of each component.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Minimum Spanning Tree problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the undirected edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, ` + "`" + `algorithm` + "`" + ` represents the algorithm to use (` + "`" + `kruskal` + "`" + ` by default, or ` + "`" + `prim` + "`" + `).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleMinimumSpanningTree.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.MinimumSpanningTreeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/n-queens": {
+            "post": {
+                "description": "Computes the solution for the specified N Queens problem instance, using the Forward Checking algorithm with MRV sorting. In the count mode, the solutions are counted with bitmask backtracking; in the all mode, they are listed page by page. The min-conflicts strategy finds the first solution of very large boards with local search. Other chess pieces can be placed instead of queens, and the maximum mode places as many non-attacking pieces as possible anywhere on the board. Boards can also be rectangular, or toroidal with edges that wrap around. The forward checking search can be traced step by step.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves N Queens problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of queens (one for each row/column), ` + "`" + `rows` + "`" + ` and ` + "`" + `cols` + "`" + ` represent the dimensions of a rectangular board (both default to ` + "`" + `n` + "`" + `) on which min(` + "`" + `rows` + "`" + `, ` + "`" + `cols` + "`" + `) pieces are placed, ` + "`" + `topology` + "`" + ` is either ` + "`" + `flat` + "`" + ` (default) or ` + "`" + `torus` + "`" + `, ` + "`" + `blocked` + "`" + ` represents the blocked squares on the chessboard, ` + "`" + `fixed` + "`" + ` represents the squares of the queens that are placed in advance, ` + "`" + `piece` + "`" + ` is either ` + "`" + `queen` + "`" + ` (default), ` + "`" + `rook` + "`" + `, ` + "`" + `bishop` + "`" + `, ` + "`" + `knight` + "`" + `, ` + "`" + `king` + "`" + `, ` + "`" + `amazon` + "`" + `, ` + "`" + `superqueen` + "`" + `, ` + "`" + `chancellor` + "`" + ` or ` + "`" + `archbishop` + "`" + `, ` + "`" + `mode` + "`" + ` is either ` + "`" + `first` + "`" + ` (default), ` + "`" + `count` + "`" + `, ` + "`" + `all` + "`" + ` or ` + "`" + `maximum` + "`" + `, ` + "`" + `strategy` + "`" + ` is either ` + "`" + `forward-checking` + "`" + ` (default) or ` + "`" + `min-conflicts` + "`" + ` and only applies to the ` + "`" + `first` + "`" + ` mode. In the ` + "`" + `all` + "`" + ` mode, ` + "`" + `limit` + "`" + ` represents the maximum number of solutions on a page (10 by default) and ` + "`" + `cursor` + "`" + ` represents the ` + "`" + `next_cursor` + "`" + ` returned by the previous page. Setting ` + "`" + `trace` + "`" + ` returns the events of the forward checking search in the ` + "`" + `first` + "`" + ` mode, up to ` + "`" + `trace_limit` + "`" + ` of them (1000 by default).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleNQueens.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.NQueensResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortest-path": {
+            "post": {
+                "description": "Computes the solution for the specified single-source Shortest Path problem instance, using Dijkstra's algorithm or, for graphs with negative weights, the Bellman-Ford algorithm. If a target node is given, only the path towards it is returned and the search stops as soon as the target is settled. Alternative routes towards the target can be found with Yen's algorithm.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Shortest Path problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `n` + "`" + ` represents the number of nodes in the graph (inferred if omitted), ` + "`" + `edges` + "`" + ` represents the edges in the graph in a [start, end, weight] format, where weights can be decimals or vectors of weights (such as [cost, time]), nodes are either integers or string names, optionally followed by a direction marker (` + "`" + `-\u003e` + "`" + `, ` + "`" + `\u003c-` + "`" + ` or ` + "`" + `\u003c-\u003e` + "`" + `), ` + "`" + `directed` + "`" + ` represents whether unmarked edges are directed (true by default), ` + "`" + `source` + "`" + ` represents the source node from which all paths will be calculated, ` + "`" + `target` + "`" + ` optionally represents the only node whose path should be calculated, ` + "`" + `k` + "`" + ` optionally represents the number of loopless paths towards the target that should be ranked, ` + "`" + `algorithm` + "`" + ` represents the algorithm to use (` + "`" + `dijkstra` + "`" + ` by default, ` + "`" + `bidirectional-dijkstra` + "`" + ` when a target is given, or ` + "`" + `bellman-ford` + "`" + ` to allow negative weights), ` + "`" + `precision` + "`" + ` represents how distances are computed (` + "`" + `float` + "`" + ` by default, or ` + "`" + `big` + "`" + ` for exact arbitrary-precision distances with Dijkstra's algorithm), ` + "`" + `mode` + "`" + ` optionally selects a multi-criteria search towards the target (` + "`" + `pareto` + "`" + ` for every Pareto-optimal path, or ` + "`" + `constrained` + "`" + ` for the cheapest path by the first weight), ` + "`" + `limits` + "`" + ` represents the upper bounds for the weights following the first one.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleShortestPath.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.ShortestPathResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/status": {
+            "get": {
+                "description": "Used for testing purposes, when the user wants to check whether the server is currently running.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Returns the health status of the server",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleStatus.StatusResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/sudoku": {
+            "post": {
+                "description": "Computes the solution for the specified Sudoku puzzle of size n^2 x n^2, using the Forward Checking algorithm with MRV sorting and arc consistency (AC-3), and checks whether the solution is unique.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Sudoku puzzle",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `grid` + "`" + ` represents the rows of the puzzle, where empty cells are 0; its size must be a perfect square (such as 4, 9 or 16), up to 16.",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleSudoku.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.SudokuResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tsp": {
+            "post": {
+                "description": "Computes a tour that visits every node exactly once and returns to node 0. Instances with at most 16 nodes are solved exactly with the Held-Karp algorithm, while larger ones are solved with a nearest-neighbor tour improved by 2-opt and Or-opt moves. The cost of the tour is compared against a lower bound, giving its optimality gap.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Solves Travelling Salesman problem",
+                "parameters": [
+                    {
+                        "description": "` + "`" + `distances` + "`" + ` represents the distance from each node (row) to each node (column), which can be asymmetric, ` + "`" + `coordinates` + "`" + ` can be given instead as a list of [x, y] points with Euclidean distances between them, ` + "`" + `tier` + "`" + ` optionally forces the tier that produces the tour (` + "`" + `exact` + "`" + ` or ` + "`" + `heuristic` + "`" + `).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.HandleTSP.requestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/solvers.TSPResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.HandleAStar.requestBody": {
+            "type": "object",
+            "properties": {
+                "connectivity": {
+                    "type": "integer"
+                },
+                "coordinates": {
+                    "$ref": "#/definitions/solvers.NodeCoordinates"
+                },
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "goal": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "grid": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "heuristic": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "source": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "start": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "target": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "handlers.HandleAllPairsShortestPath.requestBody": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "n": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.HandleAssignment.requestBody": {
+            "type": "object",
+            "properties": {
+                "costs": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number",
+                            "format": "float64"
+                        }
+                    }
+                },
+                "demand": {
+                    "type": "number"
+                },
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "objective": {
+                    "type": "string"
+                },
+                "sink": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "source": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "handlers.HandleBipartiteMatching.requestBody": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "right": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                }
+            }
+        },
+        "handlers.HandleConstraintSatisfaction.requestBody": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "constraints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.CSPConstraint"
+                    }
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "preset": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.CSPVariable"
+                    }
+                }
+            }
+        },
+        "handlers.HandleGraphAnalysis.requestBody": {
+            "type": "object",
+            "properties": {
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "n": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.HandleGraphColoring.requestBody": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "k": {
+                    "type": "integer"
+                },
+                "n": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.HandleKnapsack.requestBody": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "quantities": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "unlimited": {
+                    "type": "array",
+                    "items": {
+                        "type": "boolean"
+                    }
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "handlers.HandleMaxFlow.requestBody": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "sink": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "source": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "handlers.HandleMinimumSpanningTree.requestBody": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "n": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.HandleNQueens.requestBody": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "cols": {
+                    "type": "integer"
+                },
+                "cursor": {
+                    "type": "string"
+                },
+                "fixed": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "piece": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "integer"
+                },
+                "strategy": {
+                    "type": "string"
+                },
+                "topology": {
+                    "type": "string"
+                },
+                "trace": {
+                    "type": "boolean"
+                },
+                "trace_limit": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handlers.HandleShortestPath.requestBody": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphEdge"
+                    }
+                },
+                "k": {
+                    "type": "integer"
+                },
+                "limits": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "n": {
+                    "type": "integer"
+                },
+                "precision": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "target": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "handlers.HandleStatus.StatusResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.HandleSudoku.requestBody": {
+            "type": "object",
+            "properties": {
+                "grid": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                }
+            }
+        },
+        "handlers.HandleTSP.requestBody": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number",
+                            "format": "float64"
+                        }
+                    }
+                },
+                "distances": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number",
+                            "format": "float64"
+                        }
+                    }
+                },
+                "tier": {
+                    "type": "string"
+                }
+            }
+        },
+        "solvers.AStarResult": {
+            "type": "object",
+            "properties": {
+                "cells": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "cost": {
+                    "type": "number"
+                },
+                "expanded_nodes": {
+                    "type": "integer"
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                }
+            }
+        },
+        "solvers.AllPairsShortestPathResult": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "distances": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number",
+                            "format": "float64"
+                        }
+                    }
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "next_hops": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/solvers.NodeID"
+                        }
+                    }
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "reachable": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "boolean"
+                        }
+                    }
+                }
+            }
+        },
+        "solvers.AssignmentResult": {
+            "type": "object",
+            "properties": {
+                "flow_value": {
+                    "type": "number"
+                },
+                "flows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.AssignmentResultFlow"
+                    }
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "objective": {
+                    "type": "string"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.AssignmentResultPair"
+                    }
+                },
+                "total_cost": {
+                    "type": "number"
+                },
+                "unassigned_tasks": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "unassigned_workers": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "solvers.AssignmentResultFlow": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "number"
+                },
+                "cost": {
+                    "type": "number"
+                },
+                "flow": {
+                    "type": "number"
+                },
+                "from": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "reversed": {
+                    "type": "boolean"
+                },
+                "to": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "solvers.AssignmentResultPair": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "number"
+                },
+                "task": {
+                    "type": "integer"
+                },
+                "worker": {
+                    "type": "integer"
+                }
+            }
+        },
+        "solvers.BipartiteMatchingResult": {
+            "type": "object",
+            "properties": {
+                "formatted_output": {
+                    "type": "string"
+                },
+                "left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "right": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.BipartiteMatchingResultPair"
+                    }
+                },
+                "total_weight": {
+                    "type": "number"
+                },
+                "unmatched_left": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "unmatched_right": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                }
+            }
+        },
+        "solvers.BipartiteMatchingResultPair": {
+            "type": "object",
+            "properties": {
+                "left": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "right": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "solvers.CSPConstraint": {
+            "type": "object",
+            "properties": {
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "operator": {
+                    "type": "string"
+                },
+                "tuples": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                },
+                "variables": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "solvers.CSPResult": {
+            "type": "object",
+            "properties": {
+                "assignment": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.CSPResultVariable"
+                    }
+                }
+            }
+        },
+        "solvers.CSPResultVariable": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "solvers.CSPVariable": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "range": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "solvers.GraphAnalysisResult": {
+            "type": "object",
+            "properties": {
+                "articulation_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "bridges": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/solvers.NodeID"
+                        }
+                    }
+                },
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/solvers.NodeID"
+                        }
+                    }
+                },
+                "condensation": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "cycle": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "statistics": {
+                    "$ref": "#/definitions/solvers.GraphAnalysisResultStatistics"
+                },
+                "topological_order": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                }
+            }
+        },
+        "solvers.GraphAnalysisResultStatistics": {
+            "type": "object",
+            "properties": {
+                "acyclic": {
+                    "type": "boolean"
+                },
+                "density": {
+                    "type": "number"
+                },
+                "directed": {
+                    "type": "boolean"
+                },
+                "edges": {
+                    "type": "integer"
+                },
+                "isolated_nodes": {
+                    "type": "integer"
+                },
+                "largest_component_size": {
+                    "type": "integer"
+                },
+                "nodes": {
+                    "type": "integer"
+                },
+                "self_loops": {
+                    "type": "integer"
+                },
+                "strongly_connected_components": {
+                    "type": "integer"
+                },
+                "weakly_connected_components": {
+                    "type": "integer"
+                }
+            }
+        },
+        "solvers.GraphColoringResult": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "chromatic_number": {
+                    "type": "integer"
+                },
+                "colors": {
+                    "type": "integer"
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.GraphColoringResultNode"
+                    }
+                }
+            }
+        },
+        "solvers.GraphColoringResultNode": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "integer"
+                },
+                "node": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "solvers.GraphEdge": {
+            "type": "object",
+            "properties": {
+                "direction": {
+                    "type": "string"
+                },
+                "from": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "to": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "weight": {
+                    "type": "number",
+                    "format": "float64"
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                }
+            }
+        },
+        "solvers.KnapsackResult": {
+            "type": "object",
+            "properties": {
+                "binary_solution": {
+                    "$ref": "#/definitions/solvers.KnapsackResultData-int"
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "fractional_solution": {
+                    "$ref": "#/definitions/solvers.KnapsackResultData-float64"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "solvers.KnapsackResultData-float64": {
+            "type": "object",
+            "properties": {
+                "max_value": {
+                    "type": "number"
+                },
+                "max_weight": {
+                    "type": "number"
+                },
+                "selected_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.KnapsackResultItem-float64"
+                    }
+                }
+            }
+        },
+        "solvers.KnapsackResultData-int": {
+            "type": "object",
+            "properties": {
+                "max_value": {
+                    "type": "integer"
+                },
+                "max_weight": {
+                    "type": "integer"
+                },
+                "selected_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.KnapsackResultItem-int"
+                    }
+                }
+            }
+        },
+        "solvers.KnapsackResultItem-float64": {
+            "type": "object",
+            "properties": {
+                "copies": {
+                    "type": "number"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "ratio": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "solvers.KnapsackResultItem-int": {
+            "type": "object",
+            "properties": {
+                "copies": {
+                    "type": "integer"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "ratio": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "integer"
+                }
+            }
+        },
+        "solvers.MaxFlowResult": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "flow_value": {
+                    "type": "number"
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "min_cut": {
+                    "$ref": "#/definitions/solvers.MaxFlowResultCut"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.MaxFlowResultEdge"
+                    }
+                }
+            }
+        },
+        "solvers.MaxFlowResultCut": {
+            "type": "object",
             "properties": {
-                "max_value": {
-                    "type": "integer"
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.MaxFlowResultEdge"
+                    }
                 },
-                "max_weight": {
-                    "type": "integer"
+                "sink_side": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
                 },
-                "selected_items": {
+                "source_side": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/solvers.KnapsackResultItem-int"
+                        "$ref": "#/definitions/solvers.NodeID"
                     }
                 }
             }
         },
-        "solvers.KnapsackResultItem-float64": {
+        "solvers.MaxFlowResultEdge": {
             "type": "object",
             "properties": {
-                "number": {
-                    "type": "integer"
+                "capacity": {
+                    "type": "number"
                 },
-                "ratio": {
+                "flow": {
                     "type": "number"
                 },
-                "value": {
+                "from": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "reversed": {
+                    "type": "boolean"
+                },
+                "to": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                }
+            }
+        },
+        "solvers.MinimumSpanningTreeResult": {
+            "type": "object",
+            "properties": {
+                "algorithm": {
+                    "type": "string"
+                },
+                "components": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.MinimumSpanningTreeResultComponent"
+                    }
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.MinimumSpanningTreeResultEdge"
+                    }
+                },
+                "total_weight": {
                     "type": "number"
+                }
+            }
+        },
+        "solvers.MinimumSpanningTreeResultComponent": {
+            "type": "object",
+            "properties": {
+                "edge_count": {
+                    "type": "integer"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
                 },
                 "weight": {
                     "type": "number"
                 }
             }
         },
-        "solvers.KnapsackResultItem-int": {
+        "solvers.MinimumSpanningTreeResultEdge": {
             "type": "object",
             "properties": {
-                "number": {
+                "component": {
                     "type": "integer"
                 },
-                "ratio": {
-                    "type": "number"
+                "from": {
+                    "$ref": "#/definitions/solvers.NodeID"
                 },
-                "value": {
-                    "type": "integer"
+                "to": {
+                    "$ref": "#/definitions/solvers.NodeID"
                 },
                 "weight": {
-                    "type": "integer"
+                    "type": "number"
                 }
             }
         },
         "solvers.NQueensResult": {
             "type": "object",
             "properties": {
+                "cols": {
+                    "type": "integer"
+                },
                 "formatted_output": {
                     "type": "string"
                 },
+                "fundamental_solutions": {
+                    "type": "integer"
+                },
                 "iterations": {
                     "type": "integer"
                 },
                 "message": {
                     "type": "string"
                 },
+                "mode": {
+                    "type": "string"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "optimal": {
+                    "type": "boolean"
+                },
+                "piece": {
+                    "type": "string"
+                },
+                "placed": {
+                    "type": "integer"
+                },
+                "repair_steps": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "integer"
+                },
                 "solution": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/solvers.NQueensResultQueen"
                     }
+                },
+                "solutions": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "strategy": {
+                    "type": "string"
+                },
+                "topology": {
+                    "type": "string"
+                },
+                "total_solutions": {
+                    "type": "integer"
+                },
+                "trace": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NQueensTraceEvent"
+                    }
+                },
+                "trace_truncated": {
+                    "type": "boolean"
                 }
             }
         },
@@ -327,11 +1778,61 @@ const docTemplate = `{
                         "type": "integer"
                     }
                 },
+                "given": {
+                    "type": "boolean"
+                },
                 "row": {
                     "type": "integer"
                 }
             }
         },
+        "solvers.NQueensTraceEvent": {
+            "type": "object",
+            "properties": {
+                "domain": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "pruned": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "queen": {
+                    "type": "integer"
+                },
+                "restored": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "square": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "solvers.NodeCoordinates": {
+            "type": "object"
+        },
+        "solvers.NodeID": {
+            "type": "object"
+        },
         "solvers.ShortestPathResult": {
             "type": "object",
             "properties": {
@@ -341,6 +1842,15 @@ const docTemplate = `{
                 "message": {
                     "type": "string"
                 },
+                "paths": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.ShortestPathResultPath"
+                    }
+                },
+                "settled_nodes": {
+                    "type": "integer"
+                },
                 "solution": {
                     "type": "array",
                     "items": {
@@ -353,12 +1863,137 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "distance": {
-                    "type": "integer"
+                    "type": "number"
+                },
+                "exact_distance": {
+                    "type": "string"
                 },
                 "node": {
-                    "type": "integer"
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "reachable": {
+                    "type": "boolean"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.ShortestPathResultStep"
+                    }
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "solvers.ShortestPathResultPath": {
+            "type": "object",
+            "properties": {
+                "distance": {
+                    "type": "number"
                 },
                 "path": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.NodeID"
+                    }
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "steps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/solvers.ShortestPathResultStep"
+                    }
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "solvers.ShortestPathResultStep": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "reversed": {
+                    "type": "boolean"
+                },
+                "to": {
+                    "$ref": "#/definitions/solvers.NodeID"
+                },
+                "weight": {
+                    "type": "number"
+                },
+                "weights": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "solvers.SudokuResult": {
+            "type": "object",
+            "properties": {
+                "formatted_output": {
+                    "type": "string"
+                },
+                "iterations": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "solution": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "unique": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "solvers.TSPResult": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "number"
+                },
+                "formatted_output": {
+                    "type": "string"
+                },
+                "gap": {
+                    "type": "number"
+                },
+                "lower_bound": {
+                    "type": "number"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "tier": {
+                    "type": "string"
+                },
+                "tour": {
                     "type": "array",
                     "items": {
                         "type": "integer"
@@ -369,6 +2004,7 @@ const docTemplate = `{
         "utils.ErrorResponse": {
             "type": "object",
             "properties": {
+                "details": {},
                 "error": {
                     "type": "string"
                 }
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves A* pathfinding problem
+// @Description Computes the cheapest path between two points, using the A* search algorithm. The problem can be given either as a weighted 2D grid or as a graph whose nodes have coordinates.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleAStar.requestBody true "For grids: `grid` represents the cost of entering each cell (negative values mark walls), `start` and `goal` represent the [row, col] cells to connect, `connectivity` represents the number of allowed move directions (4 or 8). For graphs: `n` represents the number of nodes (inferred if omitted), `edges` represents the edges in a [start, end, weight] format, where nodes are either integers or string names, optionally followed by a direction marker (`->`, `<-` or `<->`), `directed` represents whether unmarked edges are directed (true by default), `coordinates` represents the [x, y] position of each node (as an object keyed by name for named nodes), `source` and `target` represent the nodes to connect. In both cases, `heuristic` represents the distance estimate to use (`manhattan`, `euclidean` or `octile`)."
+// @Success 200 {object} solvers.AStarResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /a-star [post]
+func HandleAStar(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		Grid         [][]int                 `json:"grid"`
+		Start        [2]int                  `json:"start"`
+		Goal         [2]int                  `json:"goal"`
+		Connectivity int                     `json:"connectivity"`
+		N            int                     `json:"n"`
+		Edges        []solvers.GraphEdge     `json:"edges"`
+		Directed     *bool                   `json:"directed"`
+		Coordinates  solvers.NodeCoordinates `json:"coordinates"`
+		Source       solvers.NodeID          `json:"source"`
+		Target       solvers.NodeID          `json:"target"`
+		Heuristic    string                  `json:"heuristic"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.AStarSolver{}
+	if body.Grid != nil {
+		err = solver.InitializeGrid(body.Grid, body.Start, body.Goal, body.Connectivity, body.Heuristic)
+	} else {
+		err = solver.InitializeGraph(body.N, body.Edges, body.Directed == nil || *body.Directed, body.Coordinates, body.Source, body.Target, body.Heuristic)
+	}
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	solver.Solve()
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
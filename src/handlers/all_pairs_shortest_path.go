@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves All-Pairs Shortest Path problem
+// @Description Computes the distances between every pair of nodes in the specified graph, using the Floyd-Warshall algorithm for dense graphs and Johnson's algorithm for sparse ones. Negative weights are accepted, as long as the graph contains no negative cycles.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleAllPairsShortestPath.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, optionally followed by a direction marker (`->`, `<-` or `<->`), `directed` represents whether unmarked edges are directed (true by default), `algorithm` optionally forces the algorithm to use (`floyd-warshall` or `johnson`)."
+// @Success 200 {object} solvers.AllPairsShortestPathResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /all-pairs-shortest-path [post]
+func HandleAllPairsShortestPath(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		N         int                 `json:"n"`
+		Edges     []solvers.GraphEdge `json:"edges"`
+		Directed  *bool               `json:"directed"`
+		Algorithm string              `json:"algorithm"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.AllPairsShortestPathSolver{}
+	err = solver.Initialize(body.N, body.Edges, body.Directed == nil || *body.Directed, body.Algorithm)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	err = solver.Solve()
+	if err != nil {
+		var cycleErr *solvers.NegativeCycleError
+		if errors.As(err, &cycleErr) {
+			utils.RespondWithErrorDetails(w, 400, fmt.Sprintf("%v", err), cycleErr)
+		} else {
+			utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		}
+		return
+	}
+
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
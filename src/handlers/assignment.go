@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Assignment problem
+// @Description Assigns workers to tasks using the Hungarian algorithm, so that the total cost is minimized (or maximized). The cost matrix can be rectangular, in which case some workers or tasks remain unassigned. If edges are given instead of a cost matrix, a minimum-cost flow is computed over the graph.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleAssignment.requestBody true "`costs` represents the cost of assigning each worker (row) to each task (column), `objective` represents whether the total cost is minimized (`minimize` by default) or maximized (`maximize`). For a minimum-cost flow, `n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, [capacity, cost]] format, where nodes are either integers or string names, optionally followed by a direction marker (`->`, `<-` or `<->`), `directed` represents whether unmarked edges are directed (true by default), `source` and `sink` represent the nodes the flow leaves from and arrives at, `demand` optionally represents the amount of flow to send (as much as possible by default)."
+// @Success 200 {object} solvers.AssignmentResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /assignment [post]
+func HandleAssignment(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		Costs     [][]float64         `json:"costs"`
+		Objective string              `json:"objective"`
+		N         int                 `json:"n"`
+		Edges     []solvers.GraphEdge `json:"edges"`
+		Directed  *bool               `json:"directed"`
+		Source    solvers.NodeID      `json:"source"`
+		Sink      solvers.NodeID      `json:"sink"`
+		Demand    float64             `json:"demand"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.AssignmentSolver{}
+	if body.Edges != nil {
+		err = solver.InitializeFlow(body.N, body.Edges, body.Directed == nil || *body.Directed, body.Source, body.Sink, body.Demand)
+	} else {
+		err = solver.InitializeMatrix(body.Costs, body.Objective)
+	}
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	err = solver.Solve()
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Bipartite Matching problem
+// @Description Computes a maximum-cardinality matching of the specified bipartite graph with the Hopcroft-Karp algorithm, or a matching of maximum total weight. If the graph is not bipartite, a cycle of odd length is returned as proof.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleBipartiteMatching.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, `left` and `right` optionally represent nodes that must be placed on that side (the sides of the other nodes are inferred), `mode` represents what is maximized (`cardinality` by default, or `weighted` for the total weight)."
+// @Success 200 {object} solvers.BipartiteMatchingResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /bipartite-matching [post]
+func HandleBipartiteMatching(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		N     int                 `json:"n"`
+		Edges []solvers.GraphEdge `json:"edges"`
+		Left  []solvers.NodeID    `json:"left"`
+		Right []solvers.NodeID    `json:"right"`
+		Mode  string              `json:"mode"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.BipartiteMatchingSolver{}
+	err = solver.Initialize(body.N, body.Edges, body.Left, body.Right, body.Mode)
+	if err != nil {
+		var cycleErr *solvers.OddCycleError
+		if errors.As(err, &cycleErr) {
+			utils.RespondWithErrorDetails(w, 400, fmt.Sprintf("%v", err), cycleErr)
+		} else {
+			utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		}
+		return
+	}
+
+	err = solver.Solve()
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves constraint satisfaction problem
+// @Description Computes an assignment for the declared variables that satisfies every declared constraint, using the Forward Checking algorithm with MRV sorting. Supported constraints are all-different, linear-sum, table and not-equal-offset.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleConstraintSatisfaction.requestBody true "`variables` represents the variables with their `domain` (or inclusive `range`), `constraints` represents the constraints over the variables by name. `preset` declares the variables and constraints of a known problem (`n-queens`, using `n` and `blocked`), to which the declared ones are added."
+// @Success 200 {object} solvers.CSPResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /csp [post]
+func HandleConstraintSatisfaction(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		Variables   []solvers.CSPVariable   `json:"variables"`
+		Constraints []solvers.CSPConstraint `json:"constraints"`
+		Preset      string                  `json:"preset"`
+		N           int                     `json:"n"`
+		Blocked     [][]int                 `json:"blocked"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	variables, constraints := body.Variables, body.Constraints
+	if body.Preset != "" {
+		presetVariables, presetConstraints, err := solvers.PresetCSP(body.Preset, body.N, body.Blocked)
+		if err != nil {
+			utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+			return
+		}
+		variables = append(presetVariables, variables...)
+		constraints = append(presetConstraints, constraints...)
+	}
+
+	solver := solvers.ConstraintSatisfactionSolver{}
+	err = solver.Initialize(variables, constraints)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	solver.Solve()
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Analyses the structure of a graph
+// @Description Computes the strongly connected components of the specified graph with Tarjan's algorithm, along with the condensation DAG, a topological order (or a cycle that prevents one), the bridges and articulation points, and connectivity statistics.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleGraphAnalysis.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, optionally followed by a direction marker (`->`, `<-` or `<->`), `directed` represents whether unmarked edges are directed (true by default)."
+// @Success 200 {object} solvers.GraphAnalysisResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /graph-analysis [post]
+func HandleGraphAnalysis(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		N        int                 `json:"n"`
+		Edges    []solvers.GraphEdge `json:"edges"`
+		Directed *bool               `json:"directed"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.GraphAnalysisSolver{}
+	err = solver.Initialize(body.N, body.Edges, body.Directed == nil || *body.Directed)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	solver.Solve()
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
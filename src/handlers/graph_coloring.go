@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Graph Coloring problem
+// @Description Colors the nodes of the specified graph so that no two adjacent nodes share a color, using at most k colors or, if k is omitted, as few colors as possible. The Forward Checking algorithm with MRV sorting is used by default, while DSatur gives a faster greedy coloring.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleGraphColoring.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, weight] format, where nodes are either integers or string names and the weights are ignored, `k` optionally represents the number of available colors (the chromatic number is searched for if omitted), `algorithm` represents the algorithm to use (`forward-checking` by default, or `dsatur`)."
+// @Success 200 {object} solvers.GraphColoringResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /graph-coloring [post]
+func HandleGraphColoring(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		N         int                 `json:"n"`
+		Edges     []solvers.GraphEdge `json:"edges"`
+		K         int                 `json:"k"`
+		Algorithm string              `json:"algorithm"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.GraphColoringSolver{}
+	err = solver.Initialize(body.N, body.Edges, body.K, body.Algorithm)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	solver.Solve()
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
@@ -10,18 +10,20 @@ import (
 )
 
 // @Summary Solves Knapsack problem
-// @Description Computes the solutions for the specified Knapsack problem instance, handling both the Binary and Fractional variants of the problem.
+// @Description Computes the solutions for the specified Knapsack problem instance, handling both the Binary and Fractional variants of the problem. Items can have several copies (bounded) or an unlimited number of them (unbounded), which the Binary variant handles by splitting the copies into bundles of powers of two.
 // @Accept json
 // @Produce json
-// @Param request body handlers.HandleKnapsack.requestBody true "`values` represents the list of values of each object, `weights` represents the list of weights of each object, `capacity` represents the maximum weight the knapsack can hold."
+// @Param request body handlers.HandleKnapsack.requestBody true "`values` represents the list of values of each object, `weights` represents the list of weights of each object, `capacity` represents the maximum weight the knapsack can hold, `quantities` optionally represents the number of copies of each object (1 by default, or when null), `unlimited` optionally marks the objects with an unbounded number of copies."
 // @Success 200 {object} solvers.KnapsackResult
 // @Failure 400 {object} utils.ErrorResponse
 // @Router /knapsack [post]
 func HandleKnapsack(w http.ResponseWriter, r *http.Request) {
 	type requestBody struct {
-		Values   []int `json:"values"`
-		Weights  []int `json:"weights"`
-		Capacity int   `json:"capacity"`
+		Values     []int  `json:"values"`
+		Weights    []int  `json:"weights"`
+		Capacity   int    `json:"capacity"`
+		Quantities []*int `json:"quantities"`
+		Unlimited  []bool `json:"unlimited"`
 	}
 
 	decoder := json.NewDecoder(r.Body)
@@ -33,7 +35,10 @@ func HandleKnapsack(w http.ResponseWriter, r *http.Request) {
 	}
 
 	solver := solvers.KnapsackSolver{}
-	err = solver.Initialize(body.Values, body.Weights, body.Capacity)
+	err = solver.Initialize(body.Values, body.Weights, body.Capacity, solvers.KnapsackOptions{
+		Quantities: body.Quantities,
+		Unlimited:  body.Unlimited,
+	})
 	if err != nil {
 		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
 		return
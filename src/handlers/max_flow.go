@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Maximum Flow problem
+// @Description Computes the maximum flow from the source node to the sink node of the specified capacity network, using Dinic's algorithm or the push-relabel algorithm, along with the flow on each edge and a minimum cut.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleMaxFlow.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, capacity] format, where nodes are either integers or string names, optionally followed by a direction marker (`->`, `<-` or `<->`), `directed` represents whether unmarked edges are directed (true by default), `source` represents the node the flow leaves from, `sink` represents the node the flow arrives at, `algorithm` represents the algorithm to use (`dinic` by default, or `push-relabel`)."
+// @Success 200 {object} solvers.MaxFlowResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /max-flow [post]
+func HandleMaxFlow(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		N         int                 `json:"n"`
+		Edges     []solvers.GraphEdge `json:"edges"`
+		Directed  *bool               `json:"directed"`
+		Source    solvers.NodeID      `json:"source"`
+		Sink      solvers.NodeID      `json:"sink"`
+		Algorithm string              `json:"algorithm"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.MaxFlowSolver{}
+	err = solver.Initialize(body.N, body.Edges, body.Directed == nil || *body.Directed, body.Source, body.Sink, body.Algorithm)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	err = solver.Solve()
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
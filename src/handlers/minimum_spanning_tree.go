@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Minimum Spanning Tree problem
+// @Description Computes the minimum spanning tree of the specified undirected graph, using Kruskal's or Prim's algorithm. For disconnected graphs, a minimum spanning forest is returned, along with the total weight of each component.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleMinimumSpanningTree.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the undirected edges in the graph in a [start, end, weight] format, where nodes are either integers or string names, `algorithm` represents the algorithm to use (`kruskal` by default, or `prim`)."
+// @Success 200 {object} solvers.MinimumSpanningTreeResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /minimum-spanning-tree [post]
+func HandleMinimumSpanningTree(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		N         int                 `json:"n"`
+		Edges     []solvers.GraphEdge `json:"edges"`
+		Algorithm string              `json:"algorithm"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.MinimumSpanningTreeSolver{}
+	err = solver.Initialize(body.N, body.Edges, body.Algorithm)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	solver.Solve()
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
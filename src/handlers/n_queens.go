@@ -10,17 +10,28 @@ import (
 )
 
 // @Summary Solves N Queens problem
-// @Description Computes the solution for the specified N Queens problem instance, using the Forward Checking algorithm with MRV sorting.
+// @Description Computes the solution for the specified N Queens problem instance, using the Forward Checking algorithm with MRV sorting. In the count mode, the solutions are counted with bitmask backtracking; in the all mode, they are listed page by page. The min-conflicts strategy finds the first solution of very large boards with local search. Other chess pieces can be placed instead of queens, and the maximum mode places as many non-attacking pieces as possible anywhere on the board. Boards can also be rectangular, or toroidal with edges that wrap around. The forward checking search can be traced step by step.
 // @Accept json
 // @Produce json
-// @Param request body handlers.HandleNQueens.requestBody true "`n` represents the number of queens (one for each row/column), `blocked` represents the blocked squares on the chessboard."
+// @Param request body handlers.HandleNQueens.requestBody true "`n` represents the number of queens (one for each row/column), `rows` and `cols` represent the dimensions of a rectangular board (both default to `n`) on which min(`rows`, `cols`) pieces are placed, `topology` is either `flat` (default) or `torus`, `blocked` represents the blocked squares on the chessboard, `fixed` represents the squares of the queens that are placed in advance, `piece` is either `queen` (default), `rook`, `bishop`, `knight`, `king`, `amazon`, `superqueen`, `chancellor` or `archbishop`, `mode` is either `first` (default), `count`, `all` or `maximum`, `strategy` is either `forward-checking` (default) or `min-conflicts` and only applies to the `first` mode. In the `all` mode, `limit` represents the maximum number of solutions on a page (10 by default) and `cursor` represents the `next_cursor` returned by the previous page. Setting `trace` returns the events of the forward checking search in the `first` mode, up to `trace_limit` of them (1000 by default)."
 // @Success 200 {object} solvers.NQueensResult
 // @Failure 400 {object} utils.ErrorResponse
 // @Router /n-queens [post]
 func HandleNQueens(w http.ResponseWriter, r *http.Request) {
 	type requestBody struct {
-		N       int     `json:"n"`
-		Blocked [][]int `json:"blocked"`
+		N          int     `json:"n"`
+		Rows       int     `json:"rows"`
+		Cols       int     `json:"cols"`
+		Topology   string  `json:"topology"`
+		Blocked    [][]int `json:"blocked"`
+		Fixed      [][]int `json:"fixed"`
+		Piece      string  `json:"piece"`
+		Mode       string  `json:"mode"`
+		Strategy   string  `json:"strategy"`
+		Cursor     string  `json:"cursor"`
+		Limit      int     `json:"limit"`
+		Trace      bool    `json:"trace"`
+		TraceLimit int     `json:"trace_limit"`
 	}
 
 	decoder := json.NewDecoder(r.Body)
@@ -32,7 +43,19 @@ func HandleNQueens(w http.ResponseWriter, r *http.Request) {
 	}
 
 	solver := solvers.NQueensSolver{}
-	err = solver.Initialize(body.N, body.Blocked)
+	err = solver.Initialize(body.N, body.Blocked, solvers.NQueensOptions{
+		Mode:       body.Mode,
+		Piece:      body.Piece,
+		Rows:       body.Rows,
+		Cols:       body.Cols,
+		Topology:   body.Topology,
+		Strategy:   body.Strategy,
+		Cursor:     body.Cursor,
+		Limit:      body.Limit,
+		Fixed:      body.Fixed,
+		Trace:      body.Trace,
+		TraceLimit: body.TraceLimit,
+	})
 	if err != nil {
 		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
 		return
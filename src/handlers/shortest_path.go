@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -10,18 +11,25 @@ import (
 )
 
 // @Summary Solves Shortest Path problem
-// @Description Computes the solution for the specified single-source Shortest Path problem instance, using Dijkstra's algorithm.
+// @Description Computes the solution for the specified single-source Shortest Path problem instance, using Dijkstra's algorithm or, for graphs with negative weights, the Bellman-Ford algorithm. If a target node is given, only the path towards it is returned and the search stops as soon as the target is settled. Alternative routes towards the target can be found with Yen's algorithm.
 // @Accept json
 // @Produce json
-// @Param request body handlers.HandleShortestPath.requestBody true "`n` represents the number of nodes in the graph, `edges` represents the directed edges in the graph in a [start, end, weight] format, `source` represents the source node from which all paths will be calculated."
+// @Param request body handlers.HandleShortestPath.requestBody true "`n` represents the number of nodes in the graph (inferred if omitted), `edges` represents the edges in the graph in a [start, end, weight] format, where weights can be decimals or vectors of weights (such as [cost, time]), nodes are either integers or string names, optionally followed by a direction marker (`->`, `<-` or `<->`), `directed` represents whether unmarked edges are directed (true by default), `source` represents the source node from which all paths will be calculated, `target` optionally represents the only node whose path should be calculated, `k` optionally represents the number of loopless paths towards the target that should be ranked, `algorithm` represents the algorithm to use (`dijkstra` by default, `bidirectional-dijkstra` when a target is given, or `bellman-ford` to allow negative weights), `precision` represents how distances are computed (`float` by default, or `big` for exact arbitrary-precision distances with Dijkstra's algorithm), `mode` optionally selects a multi-criteria search towards the target (`pareto` for every Pareto-optimal path, or `constrained` for the cheapest path by the first weight), `limits` represents the upper bounds for the weights following the first one."
 // @Success 200 {object} solvers.ShortestPathResult
 // @Failure 400 {object} utils.ErrorResponse
 // @Router /shortest-path [post]
 func HandleShortestPath(w http.ResponseWriter, r *http.Request) {
 	type requestBody struct {
-		N      int      `json:"n"`
-		Edges  [][3]int `json:"edges"`
-		Source int      `json:"source"`
+		N         int                 `json:"n"`
+		Edges     []solvers.GraphEdge `json:"edges"`
+		Directed  *bool               `json:"directed"`
+		Source    solvers.NodeID      `json:"source"`
+		Target    *solvers.NodeID     `json:"target"`
+		K         int                 `json:"k"`
+		Algorithm string              `json:"algorithm"`
+		Precision string              `json:"precision"`
+		Mode      string              `json:"mode"`
+		Limits    []float64           `json:"limits"`
 	}
 
 	decoder := json.NewDecoder(r.Body)
@@ -33,12 +41,30 @@ func HandleShortestPath(w http.ResponseWriter, r *http.Request) {
 	}
 
 	solver := solvers.ShortestPathSolver{}
-	err = solver.Initialize(body.N, body.Edges, body.Source)
+	err = solver.Initialize(body.N, body.Edges, body.Source, solvers.ShortestPathOptions{
+		Algorithm:  body.Algorithm,
+		Target:     body.Target,
+		K:          body.K,
+		Undirected: body.Directed != nil && !*body.Directed,
+		Precision:  body.Precision,
+		Mode:       body.Mode,
+		Limits:     body.Limits,
+	})
 	if err != nil {
 		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
 		return
 	}
 
-	solver.Solve()
+	err = solver.Solve()
+	if err != nil {
+		var cycleErr *solvers.NegativeCycleError
+		if errors.As(err, &cycleErr) {
+			utils.RespondWithErrorDetails(w, 400, fmt.Sprintf("%v", err), cycleErr)
+		} else {
+			utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		}
+		return
+	}
+
 	utils.RespondWithJSON(w, 200, solver.FormatResult())
 }
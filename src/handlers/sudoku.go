@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Sudoku puzzle
+// @Description Computes the solution for the specified Sudoku puzzle of size n^2 x n^2, using the Forward Checking algorithm with MRV sorting and arc consistency (AC-3), and checks whether the solution is unique.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleSudoku.requestBody true "`grid` represents the rows of the puzzle, where empty cells are 0; its size must be a perfect square (such as 4, 9 or 16), up to 16."
+// @Success 200 {object} solvers.SudokuResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /sudoku [post]
+func HandleSudoku(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		Grid [][]int `json:"grid"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.SudokuSolver{}
+	err = solver.Initialize(body.Grid)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	solver.Solve()
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vanessahoamea/algorithms-api/src/solvers"
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// @Summary Solves Travelling Salesman problem
+// @Description Computes a tour that visits every node exactly once and returns to node 0. Instances with at most 16 nodes are solved exactly with the Held-Karp algorithm, while larger ones are solved with a nearest-neighbor tour improved by 2-opt and Or-opt moves. The cost of the tour is compared against a lower bound, giving its optimality gap.
+// @Accept json
+// @Produce json
+// @Param request body handlers.HandleTSP.requestBody true "`distances` represents the distance from each node (row) to each node (column), which can be asymmetric, `coordinates` can be given instead as a list of [x, y] points with Euclidean distances between them, `tier` optionally forces the tier that produces the tour (`exact` or `heuristic`)."
+// @Success 200 {object} solvers.TSPResult
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /tsp [post]
+func HandleTSP(w http.ResponseWriter, r *http.Request) {
+	type requestBody struct {
+		Distances   [][]float64  `json:"distances"`
+		Coordinates [][2]float64 `json:"coordinates"`
+		Tier        string       `json:"tier"`
+	}
+
+	decoder := json.NewDecoder(r.Body)
+	body := requestBody{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("Could not parse request body: %v", err))
+		return
+	}
+
+	solver := solvers.TSPSolver{}
+	if body.Distances != nil && body.Coordinates != nil {
+		err = errors.New("Either a distance matrix or coordinates must be given, but not both.")
+	} else if body.Coordinates != nil {
+		err = solver.InitializeCoordinates(body.Coordinates, body.Tier)
+	} else {
+		err = solver.InitializeMatrix(body.Distances, body.Tier)
+	}
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	err = solver.Solve()
+	if err != nil {
+		utils.RespondWithError(w, 400, fmt.Sprintf("%v", err))
+		return
+	}
+
+	utils.RespondWithJSON(w, 200, solver.FormatResult())
+}
@@ -60,6 +60,17 @@ func main() {
 	v1Router.Post("/n-queens", handlers.HandleNQueens)
 	v1Router.Post("/knapsack", handlers.HandleKnapsack)
 	v1Router.Post("/shortest-path", handlers.HandleShortestPath)
+	v1Router.Post("/all-pairs-shortest-path", handlers.HandleAllPairsShortestPath)
+	v1Router.Post("/a-star", handlers.HandleAStar)
+	v1Router.Post("/minimum-spanning-tree", handlers.HandleMinimumSpanningTree)
+	v1Router.Post("/max-flow", handlers.HandleMaxFlow)
+	v1Router.Post("/assignment", handlers.HandleAssignment)
+	v1Router.Post("/graph-analysis", handlers.HandleGraphAnalysis)
+	v1Router.Post("/bipartite-matching", handlers.HandleBipartiteMatching)
+	v1Router.Post("/tsp", handlers.HandleTSP)
+	v1Router.Post("/graph-coloring", handlers.HandleGraphColoring)
+	v1Router.Post("/sudoku", handlers.HandleSudoku)
+	v1Router.Post("/csp", handlers.HandleConstraintSatisfaction)
 
 	router.Mount("/v1", v1Router)
 
@@ -0,0 +1,450 @@
+package solvers
+
+import (
+	"container/heap"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// Heuristics that can be selected when solving an A* problem instance
+const (
+	ManhattanHeuristic = "manhattan"
+	EuclideanHeuristic = "euclidean"
+	OctileHeuristic    = "octile"
+)
+
+// Represents the position of a node on the plane
+type point struct {
+	x float64
+	y float64
+}
+
+// Represents the positions of the nodes as given in the request, either as a list of [x, y] pairs
+// indexed by node or, for named nodes, as an object mapping each name to its [x, y] pair
+type NodeCoordinates struct {
+	list  [][2]float64
+	named map[string][2]float64
+}
+
+func (c *NodeCoordinates) UnmarshalJSON(data []byte) error {
+	c.list = nil
+	c.named = nil
+
+	err := json.Unmarshal(data, &c.list)
+	if err == nil {
+		return nil
+	}
+
+	err = json.Unmarshal(data, &c.named)
+	if err != nil {
+		return errors.New("coordinates must be either a list of [x, y] pairs or an object mapping node names to [x, y] pairs")
+	}
+
+	return nil
+}
+
+// Represents a move towards a neighboring node, having a specified cost
+type move struct {
+	node int
+	cost float64
+}
+
+// Represents a 2D map where every cell is either a wall or has a cost for entering it
+type gridMap struct {
+	rows         int
+	cols         int
+	costs        [][]int
+	connectivity int
+	minCost      float64
+}
+
+func (m *gridMap) initialize(grid [][]int, connectivity int) error {
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return errors.New("Grid must have at least one row and one column.")
+	}
+
+	if connectivity != 4 && connectivity != 8 {
+		return fmt.Errorf("Connectivity must be either 4 or 8, got %d.", connectivity)
+	}
+
+	m.rows = len(grid)
+	m.cols = len(grid[0])
+	m.costs = grid
+	m.connectivity = connectivity
+	m.minCost = math.Inf(1)
+
+	for row := range grid {
+		if len(grid[row]) != m.cols {
+			return fmt.Errorf("Row %d of the grid has %d cells, but row 0 has %d. All rows must have the same length.", row, len(grid[row]), m.cols)
+		}
+
+		for col, cost := range grid[row] {
+			if cost == 0 {
+				return fmt.Errorf("Cell [%d, %d] has a cost of 0. Costs must be positive, or negative to mark a wall.", row, col)
+			}
+			if cost > 0 {
+				m.minCost = min(m.minCost, float64(cost))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *gridMap) isWall(row int, col int) bool {
+	return m.costs[row][col] < 0
+}
+
+func (m *gridMap) inBounds(row int, col int) bool {
+	return row >= 0 && row < m.rows && col >= 0 && col < m.cols
+}
+
+func (m *gridMap) neighbors(node int) []move {
+	row, col := node/m.cols, node%m.cols
+	moves := make([]move, 0, m.connectivity)
+
+	directions := [][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+	if m.connectivity == 8 {
+		directions = append(directions, [2]int{-1, 1}, [2]int{1, 1}, [2]int{1, -1}, [2]int{-1, -1})
+	}
+
+	for _, direction := range directions {
+		nextRow, nextCol := row+direction[0], col+direction[1]
+		if !m.inBounds(nextRow, nextCol) || m.isWall(nextRow, nextCol) {
+			continue
+		}
+
+		cost := float64(m.costs[nextRow][nextCol])
+		if direction[0] != 0 && direction[1] != 0 {
+			// diagonal moves can not cut the corner of a wall
+			if m.isWall(row+direction[0], col) || m.isWall(row, col+direction[1]) {
+				continue
+			}
+			cost *= math.Sqrt2
+		}
+
+		moves = append(moves, move{node: nextRow*m.cols + nextCol, cost: cost})
+	}
+
+	return moves
+}
+
+// Handles the problem solving logic
+type AStarSolver struct {
+	grid      *gridMap
+	graph     *graph
+	positions []point
+	heuristic string
+	scale     float64
+	start     int
+	goal      int
+	distances []float64
+	previous  []int
+	expanded  int
+	solvable  bool
+}
+
+func (s *AStarSolver) InitializeGrid(grid [][]int, start [2]int, goal [2]int, connectivity int, heuristic string) error {
+	if connectivity == 0 {
+		connectivity = 4
+	}
+
+	s.grid = &gridMap{}
+	err := s.grid.initialize(grid, connectivity)
+	if err != nil {
+		return err
+	}
+
+	for _, cell := range [][2]int{start, goal} {
+		if !s.grid.inBounds(cell[0], cell[1]) {
+			return fmt.Errorf("Cell [%d, %d] is out of bounds. Rows belong to the interval [0, %d) and columns to the interval [0, %d).", cell[0], cell[1], s.grid.rows, s.grid.cols)
+		}
+		if s.grid.isWall(cell[0], cell[1]) {
+			return fmt.Errorf("Cell [%d, %d] is a wall and can not be part of the path.", cell[0], cell[1])
+		}
+	}
+
+	if heuristic == "" {
+		heuristic = ManhattanHeuristic
+		if connectivity == 8 {
+			heuristic = OctileHeuristic
+		}
+	}
+	if heuristic == ManhattanHeuristic && connectivity == 8 {
+		// a diagonal move covers a Manhattan distance of 2 for less than twice the cost, so the estimate is not admissible
+		return fmt.Errorf("The \"%s\" heuristic overestimates the cost of diagonal moves and can not be used with a connectivity of 8.", ManhattanHeuristic)
+	}
+
+	n := s.grid.rows * s.grid.cols
+	s.positions = make([]point, n)
+	for node := range n {
+		s.positions[node] = point{x: float64(node % s.grid.cols), y: float64(node / s.grid.cols)}
+	}
+
+	// every move costs at least as much as the cheapest cell, so the heuristic is scaled accordingly
+	return s.initializeSearch(n, start[0]*s.grid.cols+start[1], goal[0]*s.grid.cols+goal[1], heuristic, s.grid.minCost)
+}
+
+func (s *AStarSolver) InitializeGraph(n int, edges []GraphEdge, directed bool, coordinates NodeCoordinates, source NodeID, target NodeID, heuristic string) error {
+	s.graph = &graph{}
+	err := s.graph.initialize(n, edges, graphOptions{directed: directed})
+	if err != nil {
+		return err
+	}
+	n = s.graph.n
+
+	start, err := s.graph.resolve(source)
+	if err != nil {
+		return fmt.Errorf("Invalid source node. %v", err)
+	}
+
+	goal, err := s.graph.resolve(target)
+	if err != nil {
+		return fmt.Errorf("Invalid target node. %v", err)
+	}
+
+	if heuristic == "" {
+		heuristic = EuclideanHeuristic
+	}
+
+	s.positions = make([]point, n)
+	if s.graph.named {
+		for node, label := range s.graph.labels {
+			// the nodes without a name appear in no edge, so they are never reached and need no coordinates
+			if !label.named {
+				continue
+			}
+
+			coordinate, exists := coordinates.named[label.name]
+			if !exists {
+				return fmt.Errorf("Coordinates are missing for node \"%s\".", label.name)
+			}
+			s.positions[node] = point{x: coordinate[0], y: coordinate[1]}
+		}
+	} else {
+		if len(coordinates.list) != n {
+			return fmt.Errorf("Length of coordinates array (%d) does not match the number of nodes (%d).", len(coordinates.list), n)
+		}
+		for node, coordinate := range coordinates.list {
+			s.positions[node] = point{x: coordinate[0], y: coordinate[1]}
+		}
+	}
+
+	// coordinates are not tied to the edge weights, so the heuristic is scaled by the smallest ratio
+	// between the weight of an edge and the distance it covers, keeping the estimate admissible
+	scale := math.Inf(1)
+	for node := range n {
+		for _, edge := range s.graph.adjacencyList[node] {
+			distance := heuristicDistance(heuristic, s.positions[node], s.positions[edge.node])
+			if distance > 0 {
+				scale = min(scale, edge.weight/distance)
+			}
+		}
+	}
+	if math.IsInf(scale, 1) {
+		scale = 0
+	}
+
+	return s.initializeSearch(n, start, goal, heuristic, scale)
+}
+
+func (s *AStarSolver) initializeSearch(n int, start int, goal int, heuristic string, scale float64) error {
+	if heuristic != ManhattanHeuristic && heuristic != EuclideanHeuristic && heuristic != OctileHeuristic {
+		return fmt.Errorf("Unknown heuristic \"%s\". Supported heuristics are \"%s\", \"%s\" and \"%s\".", heuristic, ManhattanHeuristic, EuclideanHeuristic, OctileHeuristic)
+	}
+
+	s.heuristic = heuristic
+	s.scale = scale
+	s.start = start
+	s.goal = goal
+	s.expanded = 0
+	s.solvable = false
+
+	s.distances = make([]float64, n)
+	s.previous = make([]int, n)
+	for i := range n {
+		s.distances[i] = math.Inf(1)
+		s.previous[i] = -1
+	}
+	s.distances[start] = 0
+
+	return nil
+}
+
+func (s *AStarSolver) neighbors(node int) []move {
+	if s.grid != nil {
+		return s.grid.neighbors(node)
+	}
+
+	moves := make([]move, 0, len(s.graph.adjacencyList[node]))
+	for _, edge := range s.graph.adjacencyList[node] {
+		moves = append(moves, move{node: edge.node, cost: edge.weight})
+	}
+
+	return moves
+}
+
+// Computes the distance between two points, as measured by the given heuristic
+func heuristicDistance(heuristic string, a point, b point) float64 {
+	dx := math.Abs(a.x - b.x)
+	dy := math.Abs(a.y - b.y)
+
+	switch heuristic {
+	case ManhattanHeuristic:
+		return dx + dy
+	case EuclideanHeuristic:
+		return math.Hypot(dx, dy)
+	case OctileHeuristic:
+		return max(dx, dy) + (math.Sqrt2-1)*min(dx, dy)
+	}
+
+	return 0
+}
+
+// Estimates the remaining cost from the given node to the goal
+func (s *AStarSolver) estimate(node int) float64 {
+	return heuristicDistance(s.heuristic, s.positions[node], s.positions[s.goal]) * s.scale
+}
+
+func (s *AStarSolver) Solve() {
+	s.aStar()
+}
+
+func (s *AStarSolver) aStar() {
+	pq := make(utils.PriorityQueue[int, float64], 0, len(s.distances))
+	heap.Init(&pq)
+	closed := make([]bool, len(s.distances))
+
+	// initialize the heap with the start node, prioritized by its estimated total cost
+	heap.Push(&pq, &utils.Item[int, float64]{
+		Value:    s.start,
+		Priority: -s.estimate(s.start),
+	})
+
+	for pq.Len() > 0 {
+		node := heap.Pop(&pq).(*utils.Item[int, float64]).Value
+
+		// if the node has already been expanded, skip it
+		if closed[node] {
+			continue
+		}
+		closed[node] = true
+
+		if node == s.goal {
+			s.solvable = true
+			return
+		}
+		s.expanded++
+
+		for _, move := range s.neighbors(node) {
+			if closed[move.node] {
+				continue
+			}
+
+			if s.distances[node]+move.cost < s.distances[move.node] {
+				s.distances[move.node] = s.distances[node] + move.cost
+				s.previous[move.node] = node
+				heap.Push(&pq, &utils.Item[int, float64]{
+					Value:    move.node,
+					Priority: -(s.distances[move.node] + s.estimate(move.node)),
+				})
+			}
+		}
+	}
+}
+
+func (s *AStarSolver) path() []int {
+	path := make([]int, 0)
+	if !s.solvable {
+		return path
+	}
+
+	for node := s.goal; node > -1; node = s.previous[node] {
+		path = append(path, node)
+	}
+	slices.Reverse(path)
+
+	return path
+}
+
+func (s *AStarSolver) FormatResult() AStarResult {
+	result := AStarResult{}
+
+	result.ExpandedNodes = s.expanded
+	result.FormattedOutput = ""
+
+	if !s.solvable {
+		result.Message = "No solution"
+		result.Cost = -1
+		return result
+	}
+
+	result.Message = "Solution found"
+	result.Cost = s.distances[s.goal]
+	path := s.path()
+
+	if s.grid != nil {
+		result.Cells = make([][2]int, len(path))
+		for i, node := range path {
+			result.Cells[i] = [2]int{node / s.grid.cols, node % s.grid.cols}
+		}
+
+		result.FormattedOutput += s.renderGrid(path)
+	} else {
+		result.Path = s.graph.labelPath(path)
+
+		result.FormattedOutput += fmt.Sprintf("Path: %v\n", result.Path)
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Cost: %.2f\n", result.Cost)
+	result.FormattedOutput += fmt.Sprintf("Expanded nodes: %d\n", result.ExpandedNodes)
+
+	return result
+}
+
+// Draws the grid, marking walls with '#', the route with '*', and its ends with 'S' and 'G'
+func (s *AStarSolver) renderGrid(path []int) string {
+	onPath := make(map[int]bool, len(path))
+	for _, node := range path {
+		onPath[node] = true
+	}
+
+	var builder strings.Builder
+	for row := range s.grid.rows {
+		for col := range s.grid.cols {
+			node := row*s.grid.cols + col
+
+			switch {
+			case node == s.start:
+				builder.WriteByte('S')
+			case node == s.goal:
+				builder.WriteByte('G')
+			case onPath[node]:
+				builder.WriteByte('*')
+			case s.grid.isWall(row, col):
+				builder.WriteByte('#')
+			default:
+				builder.WriteByte('.')
+			}
+		}
+		builder.WriteByte('\n')
+	}
+
+	return builder.String()
+}
+
+// Represents the final solution obtained after running the algorithm
+type AStarResult struct {
+	Message         string   `json:"message"`
+	Cost            float64  `json:"cost"`
+	ExpandedNodes   int      `json:"expanded_nodes"`
+	Path            []NodeID `json:"path,omitempty"`
+	Cells           [][2]int `json:"cells,omitempty"`
+	FormattedOutput string   `json:"formatted_output"`
+}
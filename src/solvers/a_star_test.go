@@ -0,0 +1,152 @@
+package solvers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+func TestAStarGrid(t *testing.T) {
+	type testCase struct {
+		grid             [][]int
+		start            [2]int
+		goal             [2]int
+		connectivity     int
+		heuristic        string
+		expectedCost     float64
+		expectedCells    [][2]int
+		expectedRendered string
+	}
+
+	testCases := []testCase{
+		{
+			grid: [][]int{
+				{1, 1, 1, 1},
+				{1, -1, -1, 1},
+				{1, 1, 1, 1},
+			},
+			start:        [2]int{1, 0},
+			goal:         [2]int{1, 3},
+			connectivity: 4,
+			expectedCost: 5,
+			expectedCells: [][2]int{
+				{1, 0}, {0, 0}, {0, 1}, {0, 2}, {0, 3}, {1, 3},
+			},
+			expectedRendered: "****\nS##G\n....\n",
+		},
+		{
+			grid: [][]int{
+				{1, 1, 1},
+				{1, 9, 1},
+				{1, 1, 1},
+			},
+			start:        [2]int{0, 0},
+			goal:         [2]int{2, 2},
+			connectivity: 8,
+			expectedCost: 2 + 1.4142,
+			expectedCells: [][2]int{
+				{0, 0}, {0, 1}, {1, 2}, {2, 2},
+			},
+			expectedRendered: "S*.\n..*\n..G\n",
+		},
+		{
+			grid: [][]int{
+				{1, -1, 1},
+				{1, -1, 1},
+			},
+			start:        [2]int{0, 0},
+			goal:         [2]int{1, 2},
+			connectivity: 8,
+			heuristic:    EuclideanHeuristic,
+			expectedCost: -1,
+		},
+	}
+
+	for testCount, test := range testCases {
+		solver := AStarSolver{}
+		err := solver.InitializeGrid(test.grid, test.start, test.goal, test.connectivity, test.heuristic)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if !utils.FloatEqual(result.Cost, test.expectedCost) {
+			t.Errorf("The path cost does not match the one expected.\nActual: %.2f\nExpected: %.2f", result.Cost, test.expectedCost)
+		}
+		if !validateArray(result.Cells, test.expectedCells) {
+			t.Errorf("The path does not match the one expected.\nActual: %v\nExpected: %v", result.Cells, test.expectedCells)
+		}
+		if test.expectedRendered != "" && solver.renderGrid(solver.path()) != test.expectedRendered {
+			t.Errorf("The rendered grid does not match the one expected.\nActual:\n%s\nExpected:\n%s", solver.renderGrid(solver.path()), test.expectedRendered)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestAStarGraph(t *testing.T) {
+	n := 5
+	edges := [][3]int{
+		{0, 1, 5}, {0, 2, 4},
+		{1, 4, 5},
+		{2, 3, 3},
+		{3, 4, 4},
+	}
+	coordinates := NodeCoordinates{
+		list: [][2]float64{
+			{0, 0}, {4, 3}, {4, 0}, {5, 2}, {8, 3},
+		},
+	}
+
+	solver := AStarSolver{}
+	err := solver.InitializeGraph(n, toGraphEdges(edges), true, coordinates, indexNode(0), indexNode(4), "")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	solver.Solve()
+	result := solver.FormatResult()
+
+	if !utils.FloatEqual(result.Cost, 10) {
+		t.Errorf("The path cost does not match the one expected.\nActual: %.2f\nExpected: %.2f", result.Cost, 10.0)
+	}
+	if !validateArray(result.Path, toNodeIDs([]int{0, 1, 4})) {
+		t.Errorf("The path does not match the one expected.\nActual: %v\nExpected: %v", result.Path, []int{0, 1, 4})
+	}
+
+	// print solution to help with debugging
+	fmt.Printf("%s\n", result.FormattedOutput)
+
+	// validating that coordinates further apart than the edge weights still lead to the cheapest path
+	coordinates = NodeCoordinates{
+		list: [][2]float64{
+			{0, 0}, {40, 30}, {40, 0}, {50, 20}, {80, 30},
+		},
+	}
+	err = solver.InitializeGraph(n, toGraphEdges(edges), true, coordinates, indexNode(0), indexNode(4), "")
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	solver.Solve()
+	result = solver.FormatResult()
+
+	if !utils.FloatEqual(result.Cost, 10) {
+		t.Errorf("The path cost does not match the one expected.\nActual: %.2f\nExpected: %.2f", result.Cost, 10.0)
+	}
+
+	// validating that the Manhattan heuristic is rejected on 8-connected grids
+	err = solver.InitializeGrid([][]int{{1, 1}, {1, 1}}, [2]int{0, 0}, [2]int{1, 1}, 8, ManhattanHeuristic)
+	if err == nil {
+		t.Errorf("Expected the Manhattan heuristic to be rejected on an 8-connected grid.")
+	}
+}
@@ -0,0 +1,247 @@
+package solvers
+
+import (
+	"fmt"
+	"math"
+)
+
+// Algorithms that can be selected when solving an All-Pairs Shortest Path problem instance
+const (
+	FloydWarshallAlgorithm = "floyd-warshall"
+	JohnsonAlgorithm       = "johnson"
+)
+
+// Handles the problem solving logic
+type AllPairsShortestPathSolver struct {
+	graph     graph
+	algorithm string
+	distances [][]float64
+	nextHops  [][]int
+}
+
+func (s *AllPairsShortestPathSolver) Initialize(n int, edges []GraphEdge, directed bool, algorithm string) error {
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{directed: directed, allowNegative: true})
+	if err != nil {
+		return err
+	}
+	n = s.graph.n
+
+	switch algorithm {
+	case "":
+		s.algorithm = s.selectAlgorithm()
+	case FloydWarshallAlgorithm, JohnsonAlgorithm:
+		s.algorithm = algorithm
+	default:
+		return fmt.Errorf("Unknown algorithm \"%s\". Supported algorithms are \"%s\" and \"%s\".", algorithm, FloydWarshallAlgorithm, JohnsonAlgorithm)
+	}
+
+	s.distances = make([][]float64, n)
+	s.nextHops = make([][]int, n)
+	for i := range n {
+		s.distances[i] = make([]float64, n)
+		s.nextHops[i] = make([]int, n)
+		for j := range n {
+			s.distances[i][j] = math.Inf(1)
+			s.nextHops[i][j] = -1
+		}
+		s.distances[i][i] = 0
+		s.nextHops[i][i] = i
+	}
+
+	return nil
+}
+
+// Picks Floyd-Warshall for dense graphs, where its O(n^3) running time beats running Dijkstra's
+// algorithm from every node in O(n * m * log n), and Johnson's algorithm for sparse graphs
+func (s *AllPairsShortestPathSolver) selectAlgorithm() string {
+	n := float64(s.graph.n)
+	m := float64(s.graph.edgeCount)
+
+	if m*math.Log2(n+1) >= n*n {
+		return FloydWarshallAlgorithm
+	}
+
+	return JohnsonAlgorithm
+}
+
+func (s *AllPairsShortestPathSolver) Solve() error {
+	var err error
+	if s.algorithm == FloydWarshallAlgorithm {
+		err = s.floydWarshall()
+	} else {
+		err = s.johnson()
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.graph.checkOverflow()
+}
+
+func (s *AllPairsShortestPathSolver) floydWarshall() error {
+	n := s.graph.n
+
+	for node := range n {
+		for _, edge := range s.graph.adjacencyList[node] {
+			if edge.weight < s.distances[node][edge.node] {
+				s.distances[node][edge.node] = edge.weight
+				s.nextHops[node][edge.node] = edge.node
+			}
+		}
+	}
+
+	for k := range n {
+		for i := range n {
+			if math.IsInf(s.distances[i][k], 1) {
+				continue
+			}
+
+			for j := range n {
+				if math.IsInf(s.distances[k][j], 1) {
+					continue
+				}
+
+				if distance := s.graph.extend(s.distances[i][k], s.distances[k][j]); distance < s.distances[i][j] {
+					s.distances[i][j] = distance
+					s.nextHops[i][j] = s.nextHops[i][k]
+				}
+			}
+		}
+
+		// a node that can reach itself with a negative total weight lies on a negative cycle,
+		// which is then extracted from the predecessors computed by Bellman-Ford
+		for i := range n {
+			if s.distances[i][i] < 0 {
+				potentials := newPotentialsTree(n)
+				return s.graph.bellmanFord(&potentials)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *AllPairsShortestPathSolver) johnson() error {
+	n := s.graph.n
+
+	// computing node potentials as if an extra node was connected to every node with a weight of 0
+	potentials := newPotentialsTree(n)
+	err := s.graph.bellmanFord(&potentials)
+	if err != nil {
+		return err
+	}
+
+	// reweighting the edges, so that none of them are negative
+	reweighted := graph{
+		n:             n,
+		edgeCount:     s.graph.edgeCount,
+		adjacencyList: make(map[int][]edge, n),
+		integral:      s.graph.integral,
+	}
+	for node := range n {
+		reweighted.adjacencyList[node] = make([]edge, len(s.graph.adjacencyList[node]))
+		for i, e := range s.graph.adjacencyList[node] {
+			reweighted.adjacencyList[node][i] = edge{
+				node:     e.node,
+				weight:   e.weight + potentials.distances[node] - potentials.distances[e.node],
+				reversed: e.reversed,
+			}
+		}
+	}
+
+	for source := range n {
+		tree := newShortestPathTree(n, source)
+		reweighted.dijkstra(&tree, source, -1, nil)
+
+		for target := range n {
+			if math.IsInf(tree.distances[target], 1) {
+				continue
+			}
+
+			s.distances[source][target] = tree.distances[target] - potentials.distances[source] + potentials.distances[target]
+			if target != source {
+				s.nextHops[source][target] = s.firstHop(&tree, source, target)
+			}
+		}
+	}
+
+	// reweighted distances can overflow even when the original ones do not
+	s.graph.overflowed = s.graph.overflowed || reweighted.overflowed
+
+	return nil
+}
+
+// Finds the node that follows the source on its shortest path to the target
+func (s *AllPairsShortestPathSolver) firstHop(tree *shortestPathTree, source int, target int) int {
+	current := target
+	for tree.previous[current] != source {
+		current = tree.previous[current]
+	}
+
+	return current
+}
+
+func (s *AllPairsShortestPathSolver) FormatResult() AllPairsShortestPathResult {
+	n := s.graph.n
+	result := AllPairsShortestPathResult{}
+
+	result.Message = "Solution found"
+	result.Algorithm = s.algorithm
+	result.Nodes = s.graph.labels
+	result.Distances = make([][]float64, n)
+	result.NextHops = make([][]NodeID, n)
+	result.Reachable = make([][]bool, n)
+	result.FormattedOutput = ""
+
+	// every column is wide enough to fit the longest node name
+	width := 6
+	for _, label := range s.graph.labels {
+		width = max(width, len(label.String())+2)
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Distances computed with the %s algorithm:\n", s.algorithm)
+	result.FormattedOutput += fmt.Sprintf("%*s", width, "")
+	for j := range n {
+		result.FormattedOutput += fmt.Sprintf("%*s", width, s.graph.labels[j])
+	}
+	result.FormattedOutput += "\n"
+
+	for i := range n {
+		result.Distances[i] = make([]float64, n)
+		result.NextHops[i] = make([]NodeID, n)
+		result.Reachable[i] = make([]bool, n)
+		result.FormattedOutput += fmt.Sprintf("%*s", width, s.graph.labels[i])
+
+		for j := range n {
+			result.NextHops[i][j] = noNode
+			if s.nextHops[i][j] != -1 {
+				result.NextHops[i][j] = s.graph.labels[s.nextHops[i][j]]
+			}
+
+			// -1 also marks unreachable pairs, but it can be a real distance when weights are negative
+			result.Reachable[i][j] = !math.IsInf(s.distances[i][j], 1)
+			if result.Reachable[i][j] {
+				result.Distances[i][j] = s.distances[i][j]
+				result.FormattedOutput += fmt.Sprintf("%*s", width, formatWeight(s.distances[i][j]))
+			} else {
+				result.Distances[i][j] = -1
+				result.FormattedOutput += fmt.Sprintf("%*s", width, "-")
+			}
+		}
+		result.FormattedOutput += "\n"
+	}
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type AllPairsShortestPathResult struct {
+	Message         string      `json:"message"`
+	Algorithm       string      `json:"algorithm"`
+	Nodes           []NodeID    `json:"nodes"`
+	Distances       [][]float64 `json:"distances"`
+	NextHops        [][]NodeID  `json:"next_hops"`
+	Reachable       [][]bool    `json:"reachable"`
+	FormattedOutput string      `json:"formatted_output"`
+}
@@ -0,0 +1,166 @@
+package solvers
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestAllPairsShortestPath(t *testing.T) {
+	type testCase struct {
+		n                 int
+		edges             [][3]int
+		expectedAlgorithm string
+		expectedDistances [][]float64
+		expectedNextHops  [][]int
+		expectedCycle     []int
+	}
+
+	testCases := []testCase{
+		{
+			n: 4,
+			edges: [][3]int{
+				{0, 1, 3}, {0, 3, 7},
+				{1, 0, 8}, {1, 2, 2},
+				{2, 0, 5}, {2, 3, 1},
+				{3, 0, 2},
+			},
+			expectedAlgorithm: FloydWarshallAlgorithm,
+			expectedDistances: [][]float64{
+				{0, 3, 5, 6},
+				{5, 0, 2, 3},
+				{3, 6, 0, 1},
+				{2, 5, 7, 0},
+			},
+			expectedNextHops: [][]int{
+				{0, 1, 1, 1},
+				{2, 1, 2, 2},
+				{3, 3, 2, 3},
+				{0, 0, 0, 3},
+			},
+		},
+		{
+			n: 6,
+			edges: [][3]int{
+				{0, 1, 4}, {0, 2, 1},
+				{2, 1, -2},
+				{1, 3, 5},
+				{3, 4, -1},
+			},
+			expectedAlgorithm: JohnsonAlgorithm,
+			expectedDistances: [][]float64{
+				{0, -1, 1, 4, 3, -1},
+				{-1, 0, -1, 5, 4, -1},
+				{-1, -2, 0, 3, 2, -1},
+				{-1, -1, -1, 0, -1, -1},
+				{-1, -1, -1, -1, 0, -1},
+				{-1, -1, -1, -1, -1, 0},
+			},
+			expectedNextHops: [][]int{
+				{0, 2, 2, 2, 2, -1},
+				{-1, 1, -1, 3, 3, -1},
+				{-1, 1, 2, 1, 1, -1},
+				{-1, -1, -1, 3, 4, -1},
+				{-1, -1, -1, -1, 4, -1},
+				{-1, -1, -1, -1, -1, 5},
+			},
+		},
+		{
+			n: 4,
+			edges: [][3]int{
+				{0, 1, 1},
+				{1, 2, -3},
+				{2, 3, 1}, {2, 1, 2},
+			},
+			expectedCycle: []int{1, 2, 1},
+		},
+	}
+
+	for testCount, test := range testCases {
+		for _, algorithm := range []string{"", FloydWarshallAlgorithm, JohnsonAlgorithm} {
+			solver := AllPairsShortestPathSolver{}
+			err := solver.Initialize(test.n, toGraphEdges(test.edges), true, algorithm)
+
+			// validating input data
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			// validating algorithm selection
+			if algorithm == "" && test.expectedAlgorithm != "" && solver.algorithm != test.expectedAlgorithm {
+				t.Errorf("The selected algorithm does not match the one expected.\nActual: %s\nExpected: %s", solver.algorithm, test.expectedAlgorithm)
+			}
+
+			// validating negative cycle detection
+			err = solver.Solve()
+			if test.expectedCycle != nil {
+				cycleErr, ok := err.(*NegativeCycleError)
+				if !ok {
+					t.Errorf("[%s] Expected a negative cycle error, got: %v", solver.algorithm, err)
+				} else if !validateCycle(cycleErr.Cycle, toNodeIDs(test.expectedCycle)) {
+					t.Errorf("[%s] The reported cycle does not match the one expected.\nActual: %v\nExpected: %v", solver.algorithm, cycleErr.Cycle, test.expectedCycle)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			// validating solution
+			result := solver.FormatResult()
+
+			for i := range test.n {
+				if !validateArray(result.Distances[i], test.expectedDistances[i]) {
+					t.Errorf("[%s, Node %d] The actual distances do not match the ones expected.\nActual: %v\nExpected: %v", solver.algorithm, i, result.Distances[i], test.expectedDistances[i])
+				}
+				if !validateArray(result.NextHops[i], toNodeIDs(test.expectedNextHops[i])) {
+					t.Errorf("[%s, Node %d] The actual next hops do not match the ones expected.\nActual: %v\nExpected: %v", solver.algorithm, i, result.NextHops[i], test.expectedNextHops[i])
+				}
+
+				// a distance of -1 is ambiguous with negative weights, so the pairs without a next hop are the unreachable ones
+				for j := range test.n {
+					if result.Reachable[i][j] != (test.expectedNextHops[i][j] != -1) {
+						t.Errorf("[%s, Node %d] The reachability of node %d does not match the one expected.\nActual: %v\nExpected: %v", solver.algorithm, i, j, result.Reachable[i][j], test.expectedNextHops[i][j] != -1)
+					}
+				}
+			}
+
+			// print solution to help with debugging
+			if algorithm == "" {
+				fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+				fmt.Printf("%s\n", result.FormattedOutput)
+			}
+		}
+	}
+}
+
+func TestAllPairsShortestPathInfersNodeCount(t *testing.T) {
+	var namedEdges []GraphEdge
+	err := json.Unmarshal([]byte(`[["a", "b", 2], ["b", "c", 3]]`), &namedEdges)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	for _, edges := range [][]GraphEdge{toGraphEdges([][3]int{{0, 1, 2}, {1, 2, 3}}), namedEdges} {
+		for _, algorithm := range []string{FloydWarshallAlgorithm, JohnsonAlgorithm} {
+			solver := AllPairsShortestPathSolver{}
+			err := solver.Initialize(0, edges, true, algorithm)
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			err = solver.Solve()
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			if len(solver.distances) != 3 || solver.distances[0][2] != 5 {
+				t.Errorf("[%s] Expected a distance of 5 between the first and the last of the 3 inferred nodes, got the matrix %v.", algorithm, solver.distances)
+			}
+		}
+	}
+}
@@ -0,0 +1,421 @@
+package solvers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Objectives that can be selected when solving an Assignment problem instance
+const (
+	MinimizeObjective = "minimize"
+	MaximizeObjective = "maximize"
+)
+
+// Handles the problem solving logic
+type AssignmentSolver struct {
+	costs     [][]float64
+	objective string
+	rows      int
+	cols      int
+	matches   []int
+	graph     *graph
+	source    int
+	sink      int
+	demand    float64
+	arcs      []flowArc
+	outgoing  [][]int
+	flow      float64
+	cost      float64
+}
+
+// Prepares an assignment of workers (rows) to tasks (columns), where the matrix can be rectangular
+// and every worker gets at most one task
+func (s *AssignmentSolver) InitializeMatrix(costs [][]float64, objective string) error {
+	if len(costs) == 0 || len(costs[0]) == 0 {
+		return errors.New("Cost matrix must have at least one row and one column.")
+	}
+
+	s.objective = objective
+	if s.objective == "" {
+		s.objective = MinimizeObjective
+	}
+	if s.objective != MinimizeObjective && s.objective != MaximizeObjective {
+		return fmt.Errorf("Unknown objective \"%s\". Supported objectives are \"%s\" and \"%s\".", s.objective, MinimizeObjective, MaximizeObjective)
+	}
+
+	s.rows = len(costs)
+	s.cols = len(costs[0])
+	for row := range costs {
+		if len(costs[row]) != s.cols {
+			return fmt.Errorf("Row %d of the cost matrix has %d values, but row 0 has %d. All rows must have the same length.", row, len(costs[row]), s.cols)
+		}
+	}
+
+	s.costs = costs
+	s.graph = nil
+	s.matches = make([]int, s.rows)
+	s.flow = 0
+	s.cost = 0
+
+	return nil
+}
+
+// Prepares a minimum-cost flow from the source to the sink, where every edge has a [capacity, cost] pair of weights;
+// if the demand is not positive, as much flow as possible is sent
+func (s *AssignmentSolver) InitializeFlow(n int, edges []GraphEdge, directed bool, source NodeID, sink NodeID, demand float64) error {
+	for _, group := range edges {
+		if len(group.Weights) != 2 {
+			return fmt.Errorf("Edge %s must have a [capacity, cost] pair of weights.", group.String())
+		}
+		if group.Weights[0] < 0 {
+			return fmt.Errorf("Edge %s has a negative capacity: %s. Capacities must not be negative.", group.String(), formatWeight(group.Weights[0]))
+		}
+	}
+
+	s.graph = &graph{}
+	err := s.graph.initialize(n, edges, graphOptions{directed: directed, allowNegative: true})
+	if err != nil {
+		return err
+	}
+
+	s.source, err = s.graph.resolve(source)
+	if err != nil {
+		return fmt.Errorf("Invalid source node. %v", err)
+	}
+
+	s.sink, err = s.graph.resolve(sink)
+	if err != nil {
+		return fmt.Errorf("Invalid sink node. %v", err)
+	}
+
+	if s.source == s.sink {
+		return errors.New("Source and sink nodes must be different.")
+	}
+
+	s.demand = demand
+	if s.demand <= 0 {
+		s.demand = math.Inf(1)
+	}
+
+	s.costs = nil
+	s.arcs, s.outgoing = newResidualNetwork(s.graph)
+	s.flow = 0
+	s.cost = 0
+
+	return nil
+}
+
+func (s *AssignmentSolver) Solve() error {
+	if s.graph != nil {
+		return s.minCostFlow()
+	}
+
+	s.hungarian()
+	return nil
+}
+
+// Runs the Hungarian algorithm on the cost matrix, padded with zeros until it is square; workers are added
+// one at a time, and every one of them is assigned along the cheapest alternating path under the current potentials
+func (s *AssignmentSolver) hungarian() {
+	size := max(s.rows, s.cols)
+	cost := func(row int, col int) float64 {
+		if row >= s.rows || col >= s.cols {
+			return 0
+		}
+		if s.objective == MaximizeObjective {
+			return -s.costs[row][col]
+		}
+
+		return s.costs[row][col]
+	}
+
+	// rows and columns are numbered from 1, with column 0 standing for the worker being added
+	rowPotentials := make([]float64, size+1)
+	colPotentials := make([]float64, size+1)
+	owners := make([]int, size+1)
+	previous := make([]int, size+1)
+
+	for row := 1; row <= size; row++ {
+		owners[0] = row
+		col := 0
+		slack := make([]float64, size+1)
+		used := make([]bool, size+1)
+		for i := range slack {
+			slack[i] = math.Inf(1)
+		}
+
+		for owners[col] != 0 {
+			used[col] = true
+			owner := owners[col]
+			delta := math.Inf(1)
+			next := 0
+
+			for j := 1; j <= size; j++ {
+				if used[j] {
+					continue
+				}
+
+				reduced := cost(owner-1, j-1) - rowPotentials[owner] - colPotentials[j]
+				if reduced < slack[j] {
+					slack[j] = reduced
+					previous[j] = col
+				}
+				if slack[j] < delta {
+					delta = slack[j]
+					next = j
+				}
+			}
+
+			for j := 0; j <= size; j++ {
+				if used[j] {
+					rowPotentials[owners[j]] += delta
+					colPotentials[j] -= delta
+				} else {
+					slack[j] -= delta
+				}
+			}
+			col = next
+		}
+
+		// flipping the alternating path that ends in the newly reached free column
+		for col != 0 {
+			owners[col] = owners[previous[col]]
+			col = previous[col]
+		}
+	}
+
+	for i := range s.matches {
+		s.matches[i] = -1
+	}
+	for col := 1; col <= size; col++ {
+		row := owners[col] - 1
+		if row < s.rows && col-1 < s.cols {
+			s.matches[row] = col - 1
+			s.cost += s.costs[row][col-1]
+			s.flow++
+		}
+	}
+}
+
+// Sends flow along the cheapest augmenting path of the residual network until the demand is met
+// or the sink can no longer be reached
+func (s *AssignmentSolver) minCostFlow() error {
+	err := s.cancelNegativeCycles()
+	if err != nil {
+		return err
+	}
+
+	for s.demand-s.flow > flowEpsilon {
+		distances, previous, err := s.cheapestPath()
+		if err != nil {
+			return err
+		}
+		if previous[s.sink] == -1 {
+			break
+		}
+
+		// the flow along the path is limited by its arc with the smallest remaining capacity
+		amount := s.demand - s.flow
+		for node := s.sink; node != s.source; node = s.arcs[previous[node]].from {
+			amount = min(amount, s.arcs[previous[node]].remaining())
+		}
+		for node := s.sink; node != s.source; node = s.arcs[previous[node]].from {
+			s.arcs[previous[node]].flow += amount
+			s.arcs[previous[node]^1].flow -= amount
+		}
+
+		s.flow += amount
+		s.cost += amount * distances[s.sink]
+
+		if math.IsInf(s.flow, 0) {
+			return errors.New("Flow value exceeds the range of floating-point numbers. The demand must be set when the capacities are unbounded.")
+		}
+	}
+
+	return nil
+}
+
+// Saturates the cycles with a negative total cost, which lower the cost without changing the flow value,
+// so that the cheapest augmenting paths can then be found without running into any of them
+func (s *AssignmentSolver) cancelNegativeCycles() error {
+	n := s.graph.n
+	distances := make([]float64, n)
+	previous := make([]int, n)
+
+	for {
+		// every node starts at a distance of 0, as if a virtual node was linked to all of them
+		for i := range n {
+			distances[i] = 0
+			previous[i] = -1
+		}
+
+		node := s.relaxArcs(distances, previous)
+		if node == -1 {
+			return nil
+		}
+
+		// the node was still updated after n passes, so walking back n arcs from it ends on the cycle
+		for range n {
+			node = s.arcs[previous[node]].from
+		}
+
+		cycle := []int{}
+		amount := math.Inf(1)
+		cost := 0.0
+		for current := node; len(cycle) == 0 || current != node; current = s.arcs[previous[current]].from {
+			cycle = append(cycle, previous[current])
+			amount = min(amount, s.arcs[previous[current]].remaining())
+			cost += s.arcs[previous[current]].cost
+		}
+
+		if math.IsInf(amount, 1) {
+			return errors.New("Graph contains a cycle with a negative total cost and unlimited capacity, so the cost is unbounded.")
+		}
+
+		for _, arc := range cycle {
+			s.arcs[arc].flow += amount
+			s.arcs[arc^1].flow -= amount
+		}
+		s.cost += amount * cost
+	}
+}
+
+// Finds the cheapest path from the source to every node of the residual network with the Bellman-Ford algorithm,
+// which also handles the negative costs of the reverse arcs
+func (s *AssignmentSolver) cheapestPath() ([]float64, []int, error) {
+	n := s.graph.n
+	distances := make([]float64, n)
+	previous := make([]int, n)
+	for i := range n {
+		distances[i] = math.Inf(1)
+		previous[i] = -1
+	}
+	distances[s.source] = 0
+
+	// the negative cycles were canceled beforehand, and sending flow along the cheapest paths does not create new ones
+	if s.relaxArcs(distances, previous) != -1 {
+		return nil, nil, errors.New("Residual network contains a cycle with a negative total cost, so the cheapest path is undefined.")
+	}
+
+	return distances, previous, nil
+}
+
+// Relaxes the arcs with remaining capacity for up to n passes, returning a node that was still updated
+// on the last pass if there is a negative cycle, or -1 otherwise
+func (s *AssignmentSolver) relaxArcs(distances []float64, previous []int) int {
+	updated := -1
+	for range s.graph.n {
+		updated = -1
+		for i, arc := range s.arcs {
+			if math.IsInf(distances[arc.from], 1) || arc.remaining() <= flowEpsilon {
+				continue
+			}
+
+			if distances[arc.from]+arc.cost < distances[arc.to] {
+				distances[arc.to] = distances[arc.from] + arc.cost
+				previous[arc.to] = i
+				updated = arc.to
+			}
+		}
+
+		if updated == -1 {
+			break
+		}
+	}
+
+	return updated
+}
+
+func (s *AssignmentSolver) FormatResult() AssignmentResult {
+	result := AssignmentResult{}
+
+	result.Message = "Solution found"
+	result.TotalCost = s.cost
+	result.FlowValue = s.flow
+	result.FormattedOutput = ""
+
+	if s.graph != nil {
+		result.Flows = make([]AssignmentResultFlow, 0, len(s.arcs)/2)
+		for _, arc := range s.arcs {
+			if arc.residual {
+				continue
+			}
+
+			flow := AssignmentResultFlow{
+				From:     s.graph.labels[arc.from],
+				To:       s.graph.labels[arc.to],
+				Capacity: arc.capacity,
+				Cost:     arc.cost,
+				Flow:     max(0, arc.flow),
+				Reversed: arc.reversed,
+			}
+			result.Flows = append(result.Flows, flow)
+
+			result.FormattedOutput += fmt.Sprintf("Edge [%s, %s]: flow %s out of %s, at a cost of %s per unit\n", flow.From, flow.To, formatWeight(flow.Flow), formatWeight(flow.Capacity), formatWeight(flow.Cost))
+		}
+
+		if !math.IsInf(s.demand, 1) && s.demand-s.flow > flowEpsilon {
+			result.Message = fmt.Sprintf("Only %s units of flow could be sent", formatWeight(s.flow))
+		}
+		result.FormattedOutput += fmt.Sprintf("Flow: %s\n", formatWeight(s.flow))
+		result.FormattedOutput += fmt.Sprintf("Total cost: %s\n", formatWeight(s.cost))
+
+		return result
+	}
+
+	result.Objective = s.objective
+	result.Solution = make([]AssignmentResultPair, 0, s.rows)
+	result.UnassignedWorkers = make([]int, 0)
+	result.UnassignedTasks = make([]int, 0)
+
+	assigned := make([]bool, s.cols)
+	for row, col := range s.matches {
+		if col == -1 {
+			result.UnassignedWorkers = append(result.UnassignedWorkers, row)
+			result.FormattedOutput += fmt.Sprintf("Worker %d: not assigned\n", row)
+			continue
+		}
+
+		assigned[col] = true
+		result.Solution = append(result.Solution, AssignmentResultPair{Worker: row, Task: col, Cost: s.costs[row][col]})
+		result.FormattedOutput += fmt.Sprintf("Worker %d: task %d (cost %s)\n", row, col, formatWeight(s.costs[row][col]))
+	}
+	for col := range s.cols {
+		if !assigned[col] {
+			result.UnassignedTasks = append(result.UnassignedTasks, col)
+		}
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Total cost: %s (%s)\n", formatWeight(s.cost), s.objective)
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type AssignmentResult struct {
+	Message           string                 `json:"message"`
+	Objective         string                 `json:"objective,omitempty"`
+	TotalCost         float64                `json:"total_cost"`
+	FlowValue         float64                `json:"flow_value"`
+	Solution          []AssignmentResultPair `json:"solution,omitempty"`
+	UnassignedWorkers []int                  `json:"unassigned_workers,omitempty"`
+	UnassignedTasks   []int                  `json:"unassigned_tasks,omitempty"`
+	Flows             []AssignmentResultFlow `json:"flows,omitempty"`
+	FormattedOutput   string                 `json:"formatted_output"`
+}
+
+type AssignmentResultPair struct {
+	Worker int     `json:"worker"`
+	Task   int     `json:"task"`
+	Cost   float64 `json:"cost"`
+}
+
+type AssignmentResultFlow struct {
+	From     NodeID  `json:"from"`
+	To       NodeID  `json:"to"`
+	Capacity float64 `json:"capacity"`
+	Cost     float64 `json:"cost"`
+	Flow     float64 `json:"flow"`
+	Reversed bool    `json:"reversed"`
+}
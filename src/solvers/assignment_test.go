@@ -0,0 +1,169 @@
+package solvers
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestAssignmentHungarian(t *testing.T) {
+	type Test struct {
+		costs                     [][]float64
+		objective                 string
+		expectedCost              float64
+		expectedTasks             []int
+		expectedUnassignedWorkers []int
+		expectedUnassignedTasks   []int
+	}
+
+	tests := []Test{
+		{
+			costs:                     [][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}},
+			objective:                 MinimizeObjective,
+			expectedCost:              5,
+			expectedTasks:             []int{1, 0, 2},
+			expectedUnassignedWorkers: []int{},
+			expectedUnassignedTasks:   []int{},
+		},
+		{
+			costs:                     [][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}},
+			objective:                 MaximizeObjective,
+			expectedCost:              11,
+			expectedTasks:             []int{0, 2, 1},
+			expectedUnassignedWorkers: []int{},
+			expectedUnassignedTasks:   []int{},
+		},
+		{
+			costs:                     [][]float64{{1, 2, 3}, {2, 4, 6}},
+			objective:                 MinimizeObjective,
+			expectedCost:              4,
+			expectedTasks:             []int{1, 0},
+			expectedUnassignedWorkers: []int{},
+			expectedUnassignedTasks:   []int{2},
+		},
+		{
+			costs:                     [][]float64{{1, 2}, {2, 4}, {3, 6}},
+			objective:                 MaximizeObjective,
+			expectedCost:              8,
+			expectedTasks:             []int{-1, 0, 1},
+			expectedUnassignedWorkers: []int{0},
+			expectedUnassignedTasks:   []int{},
+		},
+	}
+
+	for testCount, test := range tests {
+		solver := AssignmentSolver{}
+		err := solver.InitializeMatrix(test.costs, test.objective)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		if result.TotalCost != test.expectedCost {
+			t.Errorf("[%s] The total cost does not match the one expected.\nActual: %v\nExpected: %v", test.objective, result.TotalCost, test.expectedCost)
+		}
+		if !validateArray(solver.matches, test.expectedTasks) {
+			t.Errorf("[%s] The assigned tasks do not match the ones expected.\nActual: %v\nExpected: %v", test.objective, solver.matches, test.expectedTasks)
+		}
+		if !validateArray(result.UnassignedWorkers, test.expectedUnassignedWorkers) {
+			t.Errorf("[%s] The unassigned workers do not match the ones expected.\nActual: %v\nExpected: %v", test.objective, result.UnassignedWorkers, test.expectedUnassignedWorkers)
+		}
+		if !validateArray(result.UnassignedTasks, test.expectedUnassignedTasks) {
+			t.Errorf("[%s] The unassigned tasks do not match the ones expected.\nActual: %v\nExpected: %v", test.objective, result.UnassignedTasks, test.expectedUnassignedTasks)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestAssignmentMinCostFlow(t *testing.T) {
+	type Test struct {
+		demand       float64
+		expectedFlow float64
+		expectedCost float64
+	}
+
+	var edges []GraphEdge
+	err := json.Unmarshal([]byte(`[
+		[0, 1, [2, 1]], [0, 2, [1, 2]], [1, 2, [1, 1]],
+		[1, 3, [1, 3]], [2, 3, [2, 1]]
+	]`), &edges)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	tests := []Test{
+		{demand: 0, expectedFlow: 3, expectedCost: 10},
+		{demand: 2, expectedFlow: 2, expectedCost: 6},
+		{demand: 5, expectedFlow: 3, expectedCost: 10},
+	}
+
+	for testCount, test := range tests {
+		solver := AssignmentSolver{}
+		err := solver.InitializeFlow(0, edges, true, indexNode(0), indexNode(3), test.demand)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		if result.FlowValue != test.expectedFlow {
+			t.Errorf("[Demand %v] The flow value does not match the one expected.\nActual: %v\nExpected: %v", test.demand, result.FlowValue, test.expectedFlow)
+		}
+		if result.TotalCost != test.expectedCost {
+			t.Errorf("[Demand %v] The total cost does not match the one expected.\nActual: %v\nExpected: %v", test.demand, result.TotalCost, test.expectedCost)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that every edge needs a capacity and a cost
+	solver := AssignmentSolver{}
+	err = solver.InitializeFlow(0, toGraphEdges([][3]int{{0, 1, 5}}), true, indexNode(0), indexNode(1), 0)
+	if err == nil {
+		t.Errorf("Expected edges without a cost to be rejected.")
+	}
+
+	// validating that a negative cycle with a finite capacity is saturated instead of making the cost unbounded
+	err = json.Unmarshal([]byte(`[
+		[0, 1, [2, 1]], [1, 3, [2, 1]],
+		[1, 2, [1, -5]], [2, 1, [1, 1]]
+	]`), &edges)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	err = solver.InitializeFlow(0, edges, true, indexNode(0), indexNode(3), 0)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	err = solver.Solve()
+	if err != nil {
+		t.Errorf("%s", err)
+	} else if result := solver.FormatResult(); result.FlowValue != 2 || result.TotalCost != 0 {
+		t.Errorf("Expected a flow of 2 at a cost of 0, got a flow of %v at a cost of %v.", result.FlowValue, result.TotalCost)
+	}
+}
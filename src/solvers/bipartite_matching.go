@@ -0,0 +1,384 @@
+package solvers
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Modes that can be selected when solving a Bipartite Matching problem instance
+const (
+	CardinalityMode = "cardinality"
+	WeightedMode    = "weighted"
+)
+
+// Marks the nodes that are not matched, as well as the left nodes that the breadth-first search
+// of the Hopcroft-Karp algorithm did not reach
+const unmatchedNode = -1
+
+// Handles the problem solving logic
+type BipartiteMatchingSolver struct {
+	graph     graph
+	mode      string
+	neighbors [][]int
+	weights   map[[2]int]float64
+	sides     []int
+	left      []int
+	right     []int
+	matches   []int
+}
+
+// Prepares a matching over the given edges; nodes listed in the left or right set are kept on that side,
+// while the sides of the other nodes are inferred by two-coloring the graph
+func (s *BipartiteMatchingSolver) Initialize(n int, edges []GraphEdge, left []NodeID, right []NodeID, mode string) error {
+	s.mode = mode
+	if s.mode == "" {
+		s.mode = CardinalityMode
+	}
+	if s.mode != CardinalityMode && s.mode != WeightedMode {
+		return fmt.Errorf("Unknown mode \"%s\". Supported modes are \"%s\" and \"%s\".", s.mode, CardinalityMode, WeightedMode)
+	}
+
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{allowNegative: true})
+	if err != nil {
+		return err
+	}
+
+	// edges are matched regardless of their orientation, keeping the heaviest one between every pair of nodes
+	s.neighbors = make([][]int, s.graph.n)
+	s.weights = make(map[[2]int]float64)
+	for _, group := range edges {
+		from, _ := s.graph.resolve(group.From)
+		to, _ := s.graph.resolve(group.To)
+
+		pair := [2]int{min(from, to), max(from, to)}
+		weight, exists := s.weights[pair]
+		if !exists {
+			s.neighbors[from] = append(s.neighbors[from], to)
+			if from != to {
+				s.neighbors[to] = append(s.neighbors[to], from)
+			}
+		}
+		if !exists || group.Weight > weight {
+			s.weights[pair] = group.Weight
+		}
+	}
+
+	err = s.assignSides(left, right)
+	if err != nil {
+		return err
+	}
+
+	s.left = make([]int, 0)
+	s.right = make([]int, 0)
+	for node, side := range s.sides {
+		if side == 0 {
+			s.left = append(s.left, node)
+		} else {
+			s.right = append(s.right, node)
+		}
+	}
+
+	s.matches = make([]int, s.graph.n)
+	for i := range s.matches {
+		s.matches[i] = unmatchedNode
+	}
+
+	return nil
+}
+
+// Two-colors every connected component with a breadth-first search, then flips it if needed so that the nodes
+// listed in the request end up on their side; an odd cycle is returned if the graph is not bipartite
+func (s *BipartiteMatchingSolver) assignSides(left []NodeID, right []NodeID) error {
+	n := s.graph.n
+	s.sides = make([]int, n)
+	parents := make([]int, n)
+	roots := make([]int, n)
+	depths := make([]int, n)
+	for i := range n {
+		roots[i] = -1
+	}
+
+	for root := range n {
+		if roots[root] != -1 {
+			continue
+		}
+
+		roots[root] = root
+		parents[root] = -1
+		queue := []int{root}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+
+			for _, next := range s.neighbors[node] {
+				if roots[next] == -1 {
+					roots[next] = root
+					parents[next] = node
+					depths[next] = depths[node] + 1
+					s.sides[next] = 1 - s.sides[node]
+					queue = append(queue, next)
+				} else if s.sides[next] == s.sides[node] {
+					// both tree paths, joined by the edge between their ends, form a cycle of odd length
+					cycle := treePath(node, next, parents, depths)
+					cycle = append(cycle, node)
+					return &OddCycleError{Cycle: s.graph.labelPath(cycle)}
+				}
+			}
+		}
+	}
+
+	// the first listed node of every component decides its orientation
+	flipped := make(map[int]bool)
+	anchors := make(map[int]int)
+	for side, ids := range [][]NodeID{left, right} {
+		for _, id := range ids {
+			node, err := s.graph.resolve(id)
+			if err != nil {
+				return fmt.Errorf("Invalid node in the %s set. %v", sideName(side), err)
+			}
+
+			root := roots[node]
+			anchor, exists := anchors[root]
+			if !exists {
+				anchors[root] = node
+				flipped[root] = s.sides[node] != side
+				continue
+			}
+
+			if (s.sides[node] != side) != flipped[root] {
+				path := s.graph.labelPath(treePath(anchor, node, parents, depths))
+				return fmt.Errorf("Nodes %s and %s can not be placed on the given sides, since they are connected by the path %v, whose length is %s.", s.graph.labels[anchor], s.graph.labels[node], path, parity(len(path)-1))
+			}
+		}
+	}
+
+	for node := range n {
+		if flipped[roots[node]] {
+			s.sides[node] = 1 - s.sides[node]
+		}
+	}
+
+	return nil
+}
+
+// Joins the paths from two nodes of the same search tree up to their closest common ancestor
+func treePath(from int, to int, parents []int, depths []int) []int {
+	head := []int{from}
+	tail := []int{to}
+	for from != to {
+		if depths[from] >= depths[to] {
+			from = parents[from]
+			head = append(head, from)
+		} else {
+			to = parents[to]
+			tail = append(tail, to)
+		}
+	}
+
+	slices.Reverse(tail)
+	return append(head, tail[1:]...)
+}
+
+func sideName(side int) string {
+	if side == 0 {
+		return "left"
+	}
+
+	return "right"
+}
+
+func parity(length int) string {
+	if length%2 == 0 {
+		return "even"
+	}
+
+	return "odd"
+}
+
+func (s *BipartiteMatchingSolver) Solve() error {
+	if s.mode == WeightedMode {
+		return s.weightedMatching()
+	}
+
+	s.hopcroftKarp()
+	return nil
+}
+
+// Finds a maximum-cardinality matching with the Hopcroft-Karp algorithm, which repeatedly layers the graph
+// with a breadth-first search from the unmatched left nodes, then augments along a maximal set
+// of vertex-disjoint shortest augmenting paths
+func (s *BipartiteMatchingSolver) hopcroftKarp() {
+	distances := make([]int, s.graph.n)
+
+	for s.layers(distances) {
+		for _, node := range s.left {
+			if s.matches[node] == unmatchedNode {
+				s.augment(node, distances)
+			}
+		}
+	}
+}
+
+// Computes the layer of every left node, returning whether an augmenting path exists
+func (s *BipartiteMatchingSolver) layers(distances []int) bool {
+	queue := make([]int, 0, len(s.left))
+	for _, node := range s.left {
+		if s.matches[node] == unmatchedNode {
+			distances[node] = 0
+			queue = append(queue, node)
+		} else {
+			distances[node] = unmatchedNode
+		}
+	}
+
+	found := false
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, next := range s.neighbors[node] {
+			partner := s.matches[next]
+			if partner == unmatchedNode {
+				found = true
+			} else if distances[partner] == unmatchedNode {
+				distances[partner] = distances[node] + 1
+				queue = append(queue, partner)
+			}
+		}
+	}
+
+	return found
+}
+
+// Searches for an augmenting path that follows the layers, flipping the matching along it
+func (s *BipartiteMatchingSolver) augment(node int, distances []int) bool {
+	for _, next := range s.neighbors[node] {
+		partner := s.matches[next]
+		if partner == unmatchedNode || (distances[partner] == distances[node]+1 && s.augment(partner, distances)) {
+			s.matches[node] = next
+			s.matches[next] = node
+			return true
+		}
+	}
+
+	// the node is a dead end for the current phase
+	distances[node] = unmatchedNode
+	return false
+}
+
+// Finds a matching of maximum total weight by solving an assignment problem between the left and right nodes,
+// where missing edges and edges with negative weights are never worth keeping
+func (s *BipartiteMatchingSolver) weightedMatching() error {
+	if len(s.left) == 0 || len(s.right) == 0 {
+		return nil
+	}
+
+	costs := make([][]float64, len(s.left))
+	for i, from := range s.left {
+		costs[i] = make([]float64, len(s.right))
+		for j, to := range s.right {
+			costs[i][j] = max(0, s.weight(from, to))
+		}
+	}
+
+	assignment := AssignmentSolver{}
+	err := assignment.InitializeMatrix(costs, MaximizeObjective)
+	if err != nil {
+		return err
+	}
+
+	err = assignment.Solve()
+	if err != nil {
+		return err
+	}
+
+	for i, j := range assignment.matches {
+		if j == unmatchedNode {
+			continue
+		}
+
+		from, to := s.left[i], s.right[j]
+		if _, exists := s.weights[[2]int{min(from, to), max(from, to)}]; exists && s.weight(from, to) >= 0 {
+			s.matches[from] = to
+			s.matches[to] = from
+		}
+	}
+
+	return nil
+}
+
+func (s *BipartiteMatchingSolver) weight(from int, to int) float64 {
+	return s.weights[[2]int{min(from, to), max(from, to)}]
+}
+
+func (s *BipartiteMatchingSolver) FormatResult() BipartiteMatchingResult {
+	result := BipartiteMatchingResult{}
+
+	result.Mode = s.mode
+	result.Left = s.graph.labelPath(s.left)
+	result.Right = s.graph.labelPath(s.right)
+	result.Solution = make([]BipartiteMatchingResultPair, 0)
+	result.UnmatchedLeft = make([]NodeID, 0)
+	result.UnmatchedRight = make([]NodeID, 0)
+	result.FormattedOutput = ""
+
+	for _, node := range s.left {
+		partner := s.matches[node]
+		if partner == unmatchedNode {
+			result.UnmatchedLeft = append(result.UnmatchedLeft, s.graph.labels[node])
+			continue
+		}
+
+		pair := BipartiteMatchingResultPair{Left: s.graph.labels[node], Right: s.graph.labels[partner], Weight: s.weight(node, partner)}
+		result.Solution = append(result.Solution, pair)
+		result.TotalWeight += pair.Weight
+		result.FormattedOutput += fmt.Sprintf("Node %s: matched with %s (weight %s)\n", pair.Left, pair.Right, formatWeight(pair.Weight))
+	}
+	for _, node := range s.right {
+		if s.matches[node] == unmatchedNode {
+			result.UnmatchedRight = append(result.UnmatchedRight, s.graph.labels[node])
+		}
+	}
+
+	result.Size = len(result.Solution)
+	result.Message = "Solution found"
+	if len(s.left) == len(s.right) && result.Size == len(s.left) {
+		result.Message = "Perfect matching found"
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Unmatched left nodes: %v\n", result.UnmatchedLeft)
+	result.FormattedOutput += fmt.Sprintf("Unmatched right nodes: %v\n", result.UnmatchedRight)
+	result.FormattedOutput += fmt.Sprintf("Matching size: %d, total weight: %s\n", result.Size, formatWeight(result.TotalWeight))
+
+	return result
+}
+
+// Represents an odd cycle in the graph, which proves that its nodes can not be split into two sides
+type OddCycleError struct {
+	Cycle []NodeID `json:"cycle"`
+}
+
+func (e *OddCycleError) Error() string {
+	return fmt.Sprintf("Graph is not bipartite, since it contains a cycle of odd length: %v.", e.Cycle)
+}
+
+// Represents the final solution obtained after running the algorithm
+type BipartiteMatchingResult struct {
+	Message         string                        `json:"message"`
+	Mode            string                        `json:"mode"`
+	Size            int                           `json:"size"`
+	TotalWeight     float64                       `json:"total_weight"`
+	Solution        []BipartiteMatchingResultPair `json:"solution"`
+	Left            []NodeID                      `json:"left"`
+	Right           []NodeID                      `json:"right"`
+	UnmatchedLeft   []NodeID                      `json:"unmatched_left"`
+	UnmatchedRight  []NodeID                      `json:"unmatched_right"`
+	FormattedOutput string                        `json:"formatted_output"`
+}
+
+type BipartiteMatchingResultPair struct {
+	Left   NodeID  `json:"left"`
+	Right  NodeID  `json:"right"`
+	Weight float64 `json:"weight"`
+}
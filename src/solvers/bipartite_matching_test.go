@@ -0,0 +1,120 @@
+package solvers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBipartiteMatching(t *testing.T) {
+	type Test struct {
+		n              int
+		edges          [][3]int
+		left           []int
+		mode           string
+		expectedSize   int
+		expectedWeight float64
+		expectedLeft   []int
+	}
+
+	tests := []Test{
+		{
+			n:              8,
+			edges:          [][3]int{{0, 4, 1}, {0, 5, 1}, {1, 4, 1}, {2, 5, 1}, {2, 6, 1}, {3, 6, 1}, {3, 7, 1}},
+			left:           []int{0},
+			mode:           CardinalityMode,
+			expectedSize:   4,
+			expectedWeight: 4,
+			expectedLeft:   []int{0, 1, 2, 3},
+		},
+		{
+			n:              6,
+			edges:          [][3]int{{0, 3, 1}, {1, 3, 1}, {2, 3, 1}, {2, 4, 1}},
+			left:           []int{3},
+			mode:           CardinalityMode,
+			expectedSize:   2,
+			expectedWeight: 2,
+			expectedLeft:   []int{3, 4, 5},
+		},
+		{
+			n:              4,
+			edges:          [][3]int{{0, 2, 3}, {0, 3, 2}, {1, 2, 2}, {1, 3, -1}},
+			left:           []int{0},
+			mode:           WeightedMode,
+			expectedSize:   2,
+			expectedWeight: 4,
+			expectedLeft:   []int{0, 1},
+		},
+		{
+			n:              4,
+			edges:          [][3]int{{0, 2, 1}, {0, 3, 5}, {1, 2, -3}},
+			left:           []int{0},
+			mode:           WeightedMode,
+			expectedSize:   1,
+			expectedWeight: 5,
+			expectedLeft:   []int{0, 1},
+		},
+	}
+
+	for testCount, test := range tests {
+		solver := BipartiteMatchingSolver{}
+		err := solver.Initialize(test.n, toGraphEdges(test.edges), toNodeIDs(test.left), nil, test.mode)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		if result.Size != test.expectedSize {
+			t.Errorf("[%s] The matching size does not match the one expected.\nActual: %d\nExpected: %d", test.mode, result.Size, test.expectedSize)
+		}
+		if result.TotalWeight != test.expectedWeight {
+			t.Errorf("[%s] The total weight does not match the one expected.\nActual: %v\nExpected: %v", test.mode, result.TotalWeight, test.expectedWeight)
+		}
+		if !validateArray(solver.left, test.expectedLeft) {
+			t.Errorf("[%s] The left side does not match the one expected.\nActual: %v\nExpected: %v", test.mode, solver.left, test.expectedLeft)
+		}
+
+		// validating that every node is matched at most once, along an existing edge
+		for _, pair := range result.Solution {
+			if solver.matches[pair.Right.index] != pair.Left.index {
+				t.Errorf("[%s] Node %s is not matched back with %s.", test.mode, pair.Right, pair.Left)
+			}
+			if _, exists := solver.weights[[2]int{min(pair.Left.index, pair.Right.index), max(pair.Left.index, pair.Right.index)}]; !exists {
+				t.Errorf("[%s] Nodes %s and %s are matched without an edge between them.", test.mode, pair.Left, pair.Right)
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d (%s) -------------------\n", testCount+1, test.mode)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestBipartiteMatchingOddCycle(t *testing.T) {
+	solver := BipartiteMatchingSolver{}
+	err := solver.Initialize(0, toGraphEdges([][3]int{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 4, 1}, {4, 0, 1}}), nil, nil, "")
+
+	var cycleErr *OddCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Expected an odd cycle to be reported, got: %v", err)
+	}
+	if !validateCycle(cycleErr.Cycle, toNodeIDs([]int{0, 1, 2, 3, 4, 0})) && !validateCycle(cycleErr.Cycle, toNodeIDs([]int{0, 4, 3, 2, 1, 0})) {
+		t.Errorf("The odd cycle does not match the one expected.\nActual: %v", cycleErr.Cycle)
+	}
+
+	// validating that the given sides must be consistent with the edges
+	err = solver.Initialize(0, toGraphEdges([][3]int{{0, 1, 1}, {1, 2, 1}}), toNodeIDs([]int{0, 1}), nil, "")
+	if err == nil {
+		t.Errorf("Expected nodes connected by a path of odd length to be rejected on the same side.")
+	}
+}
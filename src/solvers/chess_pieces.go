@@ -0,0 +1,286 @@
+package solvers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Pieces that can be placed on the chessboard; the composite ones combine the moves of the standard pieces
+const (
+	QueenPiece      = "queen"
+	RookPiece       = "rook"
+	BishopPiece     = "bishop"
+	KnightPiece     = "knight"
+	KingPiece       = "king"
+	AmazonPiece     = "amazon"
+	SuperqueenPiece = "superqueen"
+	ChancellorPiece = "chancellor"
+	ArchbishopPiece = "archbishop"
+)
+
+// Represents the moves of a chess piece: along rows and columns, along diagonals, jumps like a knight or steps like a king
+type chessPiece struct {
+	name     string
+	symbol   string
+	straight bool
+	diagonal bool
+	knight   bool
+	king     bool
+}
+
+var chessPieces = map[string]chessPiece{
+	QueenPiece:      {name: QueenPiece, symbol: "Q", straight: true, diagonal: true},
+	RookPiece:       {name: RookPiece, symbol: "R", straight: true},
+	BishopPiece:     {name: BishopPiece, symbol: "B", diagonal: true},
+	KnightPiece:     {name: KnightPiece, symbol: "N", knight: true},
+	KingPiece:       {name: KingPiece, symbol: "K", king: true},
+	AmazonPiece:     {name: AmazonPiece, symbol: "A", straight: true, diagonal: true, knight: true},
+	SuperqueenPiece: {name: SuperqueenPiece, symbol: "S", straight: true, diagonal: true, knight: true},
+	ChancellorPiece: {name: ChancellorPiece, symbol: "C", straight: true, knight: true},
+	ArchbishopPiece: {name: ArchbishopPiece, symbol: "H", diagonal: true, knight: true},
+}
+
+var (
+	knightJumps = [][2]int{{-2, -1}, {-2, 1}, {-1, -2}, {-1, 2}, {1, -2}, {1, 2}, {2, -1}, {2, 1}}
+	kingSteps   = [][2]int{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+)
+
+func newChessPiece(name string) (chessPiece, error) {
+	if name == "" {
+		name = QueenPiece
+	}
+
+	piece, exists := chessPieces[name]
+	if !exists {
+		names := []string{QueenPiece, RookPiece, BishopPiece, KnightPiece, KingPiece, AmazonPiece, SuperqueenPiece, ChancellorPiece, ArchbishopPiece}
+		return chessPiece{}, fmt.Errorf("Unknown piece \"%s\". Supported pieces are %s.", name, strings.Join(names, ", "))
+	}
+
+	return piece, nil
+}
+
+// Returns the name of the piece starting with a capital letter, as used at the beginning of sentences
+func (p chessPiece) title() string {
+	return strings.ToUpper(p.name[:1]) + p.name[1:]
+}
+
+// Checks whether the piece is one of the standard chess pieces, rather than a composite one
+func (p chessPiece) isStandard() bool {
+	return p.name == QueenPiece || (p.straight != p.diagonal && !p.knight && !p.king) || (!p.straight && !p.diagonal && p.knight != p.king)
+}
+
+// Checks whether the piece moves at least like a queen, in which case its placements keep the symmetries of the N-Queens problem
+func (p chessPiece) movesLikeQueen() bool {
+	return p.straight && p.diagonal
+}
+
+// Returns the rows attacked by a piece on the given row, in a column at the given distance from its own;
+// outside of a torus, some of the rows might be outside of the board
+func (p chessPiece) attackedRows(shape boardShape, row int, distance int) []int {
+	rows := make([]int, 0, 8)
+	if p.straight {
+		rows = append(rows, row)
+	}
+
+	// on a torus, the other column is also reached by going around the board the other way
+	offsets := []int{distance}
+	if shape.torus {
+		offsets = append(offsets, distance-shape.cols, distance+shape.cols)
+	}
+	for _, offset := range offsets {
+		steps := max(offset, -offset)
+		if p.diagonal && !shape.torus {
+			rows = append(rows, row+offset, row-offset)
+		}
+		if p.knight && steps >= 1 && steps <= 2 {
+			rows = append(rows, row+3-steps, row-3+steps)
+		}
+		if p.king && steps <= 1 {
+			rows = append(rows, row-1, row, row+1)
+		}
+	}
+	if !shape.torus {
+		return rows
+	}
+
+	// a diagonal of a torus keeps wrapping around until it returns to its first square, which makes it cross every column
+	// on all the rows that leave the same remainder modulo the greatest common divisor of the dimensions
+	if p.diagonal {
+		period := gcd(shape.rows, shape.cols)
+		for attacked := modulo(row+distance, period); attacked < shape.rows; attacked += period {
+			rows = append(rows, attacked)
+		}
+		for attacked := modulo(row-distance, period); attacked < shape.rows; attacked += period {
+			rows = append(rows, attacked)
+		}
+	}
+	for i := range rows {
+		rows[i] = modulo(rows[i], shape.rows)
+	}
+
+	return rows
+}
+
+// Checks whether a piece on the first square attacks a piece on the second one
+func (p chessPiece) attacks(shape boardShape, row int, col int, otherRow int, otherCol int) bool {
+	if row == otherRow && col == otherCol {
+		return false
+	}
+	if col == otherCol && p.straight {
+		return true
+	}
+
+	return slices.Contains(p.attackedRows(shape, row, otherCol-col), otherRow)
+}
+
+// Topologies of the chessboard: a flat board, or a torus whose opposite edges are joined
+const (
+	FlatTopology  = "flat"
+	TorusTopology = "torus"
+)
+
+// Represents the dimensions of the chessboard and whether its opposite edges are joined, making it a torus
+type boardShape struct {
+	rows  int
+	cols  int
+	torus bool
+}
+
+// Builds the shape of the board, which is n x n unless the rows or the columns are given
+func newBoardShape(n int, rows int, cols int, topology string) (boardShape, error) {
+	shape := boardShape{rows: rows, cols: cols}
+	if shape.rows == 0 {
+		shape.rows = n
+	}
+	if shape.cols == 0 {
+		shape.cols = n
+	}
+	if shape.rows <= 0 || shape.cols <= 0 {
+		return boardShape{}, fmt.Errorf("Board dimensions must be positive, got %d rows and %d columns.", shape.rows, shape.cols)
+	}
+
+	switch topology {
+	case "", FlatTopology:
+	case TorusTopology:
+		shape.torus = true
+	default:
+		return boardShape{}, fmt.Errorf("Unknown topology \"%s\". Supported topologies are \"%s\" and \"%s\".", topology, FlatTopology, TorusTopology)
+	}
+
+	return shape, nil
+}
+
+func (b boardShape) isSquare() bool {
+	return b.rows == b.cols
+}
+
+func (b boardShape) contains(row int, col int) bool {
+	return row >= 0 && row < b.rows && col >= 0 && col < b.cols
+}
+
+// Returns the shape of the board with its rows and columns swapped
+func (b boardShape) transpose() boardShape {
+	return boardShape{rows: b.cols, cols: b.rows, torus: b.torus}
+}
+
+// Returns the remainder of the division, which is never negative
+func modulo(value int, divisor int) int {
+	return (value%divisor + divisor) % divisor
+}
+
+func gcd(a int, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
+
+// Checks that the blocked squares are inside the board
+func validateBlockedSquares(shape boardShape, blocked [][]int) error {
+	for _, pair := range blocked {
+		if len(pair) != 2 || !shape.contains(pair[0], pair[1]) {
+			return fmt.Errorf("Blocked pair is out of bounds: %v. Row values belong to the interval [0, %d) and column values to the interval [0, %d).", pair, shape.rows, shape.cols)
+		}
+	}
+
+	return nil
+}
+
+// Checks that the fixed pieces are inside the board, not on blocked squares, and that no two of them attack each other;
+// when every row or every column holds exactly one piece (as given by the line, which is empty otherwise),
+// no two fixed pieces can share it either
+func validateFixedPieces(shape boardShape, piece chessPiece, blocked [][]int, fixed [][]int, line string) error {
+	blockedSquares := make(map[[2]int]bool, len(blocked))
+	for _, pair := range blocked {
+		blockedSquares[[2]int{pair[0], pair[1]}] = true
+	}
+
+	// keeping the first fixed piece found on every line, so that every attack is found in linear time;
+	// the diagonals of a torus are told apart by the remainder of their squares modulo the greatest common divisor of the dimensions
+	lines := []string{"row", "column", "diagonal", "anti-diagonal"}
+	attacking := []bool{piece.straight || line == lines[0], piece.straight || line == lines[1], piece.diagonal, piece.diagonal}
+	occupied := make([]map[int][2]int, len(lines))
+	for i := range occupied {
+		occupied[i] = make(map[int][2]int)
+	}
+	squares := make(map[[2]int]bool, len(fixed))
+
+	for _, pair := range fixed {
+		if len(pair) != 2 || !shape.contains(pair[0], pair[1]) {
+			return fmt.Errorf("Fixed %s is out of bounds: %v. Row values belong to the interval [0, %d) and column values to the interval [0, %d).", piece.name, pair, shape.rows, shape.cols)
+		}
+
+		row, col := pair[0], pair[1]
+		if blockedSquares[[2]int{row, col}] {
+			return fmt.Errorf("Fixed %s (%d, %d) is placed on a blocked square.", piece.name, row, col)
+		}
+		if squares[[2]int{row, col}] {
+			return fmt.Errorf("%s (%d, %d) is fixed more than once.", piece.title(), row, col)
+		}
+
+		diagonal, antiDiagonal := row-col, row+col
+		if shape.torus {
+			period := gcd(shape.rows, shape.cols)
+			diagonal, antiDiagonal = modulo(diagonal, period), modulo(antiDiagonal, period)
+		}
+		for i, key := range []int{row, col, diagonal, antiDiagonal} {
+			other, exists := occupied[i][key]
+			if exists && !piece.straight && lines[i] == line {
+				return fmt.Errorf("Fixed %ss (%d, %d) and (%d, %d) are on the same %s, but every %s holds exactly one piece.", piece.name, other[0], other[1], row, col, line, line)
+			}
+			if exists {
+				return fmt.Errorf("Fixed %ss (%d, %d) and (%d, %d) attack each other, since they are on the same %s.", piece.name, other[0], other[1], row, col, lines[i])
+			}
+			if attacking[i] {
+				occupied[i][key] = [2]int{row, col}
+			}
+		}
+
+		// the pieces that jump or step only attack a few squares around them, which wrap around the edges of a torus
+		reasons := []string{"a knight's move apart", "next to each other"}
+		moves := [][][2]int{nil, nil}
+		if piece.knight {
+			moves[0] = knightJumps
+		}
+		if piece.king {
+			moves[1] = kingSteps
+		}
+		for i, offsets := range moves {
+			for _, offset := range offsets {
+				other := [2]int{row + offset[0], col + offset[1]}
+				if shape.torus {
+					other = [2]int{modulo(other[0], shape.rows), modulo(other[1], shape.cols)}
+				}
+				if squares[other] {
+					return fmt.Errorf("Fixed %ss (%d, %d) and (%d, %d) attack each other, since they are %s.", piece.name, other[0], other[1], row, col, reasons[i])
+				}
+			}
+		}
+
+		squares[[2]int{row, col}] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,439 @@
+package solvers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+)
+
+// Types of constraints that can be declared in a constraint satisfaction problem
+const (
+	AllDifferentConstraint   = "all-different"
+	LinearSumConstraint      = "linear-sum"
+	TableConstraint          = "table"
+	NotEqualOffsetConstraint = "not-equal-offset"
+)
+
+// Presets that declare the variables and constraints of well-known problems
+const (
+	NQueensPreset = "n-queens"
+)
+
+// Operators that compare the weighted sum of a linear-sum constraint with its value
+var linearOperators = []string{"=", "!=", "<", "<=", ">", ">="}
+
+// Limits the number of iterations of the search, since the declared problems can be arbitrarily hard,
+// as well as the number of values in the domain of a variable
+const (
+	maxCSPIterations = 100000
+	maxDomainSize    = 100000
+)
+
+// Marks the variables of a declared problem that were not assigned yet, since any integer is a valid value
+const unassignedValue = math.MinInt
+
+// Represents a variable declared in the request, whose domain is either listed or given as an inclusive [min, max] range
+type CSPVariable struct {
+	Name   string `json:"name"`
+	Domain []int  `json:"domain"`
+	Range  []int  `json:"range"`
+}
+
+// Represents a constraint declared in the request; the fields that are used depend on its type:
+//   - all-different: the variables must all take different values
+//   - linear-sum: the sum of the variables, multiplied by their coefficients (1 by default), is compared with the value
+//   - table: the values of the variables must match one of the tuples
+//   - not-equal-offset: the first variable must not be equal to the second one plus the offset
+type CSPConstraint struct {
+	Type         string   `json:"type"`
+	Variables    []string `json:"variables"`
+	Coefficients []int    `json:"coefficients"`
+	Operator     string   `json:"operator"`
+	Value        int      `json:"value"`
+	Tuples       [][]int  `json:"tuples"`
+	Offset       int      `json:"offset"`
+}
+
+// Represents a constraint over the indices of its variables
+type cspConstraint struct {
+	CSPConstraint
+	variables []int
+}
+
+// Checks whether the variable can take the value, given the values of the assigned variables
+// and the domains of the unassigned ones that share the constraint
+func (c *cspConstraint) supports(p *cspProblem, variable int, value int) bool {
+	position := slices.Index(c.variables, variable)
+
+	switch c.Type {
+	case AllDifferentConstraint:
+		for _, other := range c.variables {
+			if other != variable && p.isAssigned(other) && p.values[other] == value {
+				return false
+			}
+		}
+		return true
+
+	case NotEqualOffsetConstraint:
+		other := c.variables[1-position]
+		if !p.isAssigned(other) {
+			return true
+		}
+		if position == 0 {
+			return value != p.values[other]+c.Offset
+		}
+		return p.values[other] != value+c.Offset
+
+	case LinearSumConstraint:
+		// the sum can reach any value between the smallest and largest contributions of the other variables
+		low := c.Coefficients[position] * value
+		high := low
+		for i, other := range c.variables {
+			if other == variable {
+				continue
+			}
+
+			if p.isAssigned(other) {
+				low += c.Coefficients[i] * p.values[other]
+				high += c.Coefficients[i] * p.values[other]
+				continue
+			}
+			if len(p.domains[other]) == 0 {
+				return false
+			}
+
+			smallest, largest := domainBounds(p.domains[other])
+			low += min(c.Coefficients[i]*smallest, c.Coefficients[i]*largest)
+			high += max(c.Coefficients[i]*smallest, c.Coefficients[i]*largest)
+		}
+
+		switch c.Operator {
+		case "=":
+			return low <= c.Value && c.Value <= high
+		case "!=":
+			return low != high || low != c.Value
+		case "<":
+			return low < c.Value
+		case "<=":
+			return low <= c.Value
+		case ">":
+			return high > c.Value
+		default:
+			return high >= c.Value
+		}
+
+	default:
+		for _, tuple := range c.Tuples {
+			if tuple[position] != value {
+				continue
+			}
+
+			matches := true
+			for i, other := range c.variables {
+				if other == variable {
+					continue
+				}
+				if (p.isAssigned(other) && p.values[other] != tuple[i]) || (!p.isAssigned(other) && !p.domains[other][tuple[i]]) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func domainBounds(domain cspDomain) (int, int) {
+	values := slices.Collect(maps.Keys(domain))
+	return slices.Min(values), slices.Max(values)
+}
+
+// Represents a constraint satisfaction problem declared in the request, where the constraints are shared between copies
+type cspProblem struct {
+	values      []int
+	domains     []cspDomain
+	constraints []cspConstraint
+	involved    [][]int
+}
+
+func (p *cspProblem) variableCount() int {
+	return len(p.values)
+}
+
+func (p *cspProblem) isAssigned(variable int) bool {
+	return p.values[variable] != unassignedValue
+}
+
+func (p *cspProblem) domain(variable int) cspDomain {
+	return p.domains[variable]
+}
+
+func (p *cspProblem) assign(variable int, value int) {
+	p.values[variable] = value
+	for _, index := range p.involved[variable] {
+		p.prune(&p.constraints[index])
+	}
+}
+
+// Removes the values that the constraint no longer supports from the domains of its unassigned variables
+func (p *cspProblem) prune(constraint *cspConstraint) {
+	for _, variable := range constraint.variables {
+		if p.isAssigned(variable) {
+			continue
+		}
+
+		for value := range p.domains[variable] {
+			if !constraint.supports(p, variable, value) {
+				delete(p.domains[variable], value)
+			}
+		}
+	}
+}
+
+func (p *cspProblem) cloneDeep() *cspProblem {
+	copy := cspProblem{
+		values:      slices.Clone(p.values),
+		domains:     make([]cspDomain, len(p.domains)),
+		constraints: p.constraints,
+		involved:    p.involved,
+	}
+
+	for variable, domain := range p.domains {
+		copy.domains[variable] = maps.Clone(domain)
+	}
+
+	return &copy
+}
+
+// Handles the problem solving logic
+type ConstraintSatisfactionSolver struct {
+	problem    *cspProblem
+	names      []string
+	order      map[int]int
+	domains    map[int]cspDomain
+	iterations int
+	solvable   bool
+	exhausted  bool
+}
+
+func (s *ConstraintSatisfactionSolver) Initialize(variables []CSPVariable, constraints []CSPConstraint) error {
+	if len(variables) == 0 {
+		return errors.New("At least one variable must be declared.")
+	}
+
+	s.problem = &cspProblem{
+		values:      make([]int, len(variables)),
+		domains:     make([]cspDomain, len(variables)),
+		constraints: make([]cspConstraint, 0, len(constraints)),
+		involved:    make([][]int, len(variables)),
+	}
+	s.names = make([]string, len(variables))
+	indices := make(map[string]int, len(variables))
+
+	for i, variable := range variables {
+		if variable.Name == "" {
+			return fmt.Errorf("Variable %d has no name.", i)
+		}
+		if _, exists := indices[variable.Name]; exists {
+			return fmt.Errorf("Variable \"%s\" is declared more than once.", variable.Name)
+		}
+		indices[variable.Name] = i
+		s.names[i] = variable.Name
+
+		// the domain is either listed or given as a range, but not both
+		domain := make(cspDomain)
+		if variable.Range != nil {
+			if variable.Domain != nil || len(variable.Range) != 2 || variable.Range[0] > variable.Range[1] {
+				return fmt.Errorf("Variable \"%s\" must have either a domain or a [min, max] range, where min is not larger than max.", variable.Name)
+			}
+			// the size is computed without overflowing for ranges that span most of the integers
+			size := uint64(variable.Range[1]) - uint64(variable.Range[0])
+			if size >= maxDomainSize {
+				return fmt.Errorf("Range of variable \"%s\" has more than %d values.", variable.Name, maxDomainSize)
+			}
+			for offset := range int(size) + 1 {
+				domain[variable.Range[0]+offset] = true
+			}
+		}
+		for _, value := range variable.Domain {
+			domain[value] = true
+		}
+		if domain[unassignedValue] {
+			return fmt.Errorf("Domain of variable \"%s\" can not contain %d, which marks unassigned variables.", variable.Name, unassignedValue)
+		}
+
+		s.problem.values[i] = unassignedValue
+		s.problem.domains[i] = domain
+	}
+
+	for i, declared := range constraints {
+		constraint := cspConstraint{CSPConstraint: declared, variables: make([]int, len(declared.Variables))}
+		for j, name := range declared.Variables {
+			index, exists := indices[name]
+			if !exists {
+				return fmt.Errorf("Constraint %d refers to an unknown variable \"%s\".", i, name)
+			}
+			if slices.Contains(constraint.variables[:j], index) {
+				return fmt.Errorf("Constraint %d uses variable \"%s\" more than once.", i, name)
+			}
+			constraint.variables[j] = index
+		}
+
+		err := validateConstraint(i, &constraint)
+		if err != nil {
+			return err
+		}
+
+		s.problem.constraints = append(s.problem.constraints, constraint)
+		for _, variable := range constraint.variables {
+			s.problem.involved[variable] = append(s.problem.involved[variable], i)
+		}
+	}
+
+	// constraints that hold no matter what, such as the bounds of a linear sum, are enforced before the search
+	for i := range s.problem.constraints {
+		s.problem.prune(&s.problem.constraints[i])
+	}
+
+	s.order = make(map[int]int)
+	s.domains = make(map[int]cspDomain)
+	s.iterations = 0
+	s.solvable = true
+	s.exhausted = false
+
+	return nil
+}
+
+func validateConstraint(index int, constraint *cspConstraint) error {
+	count := len(constraint.variables)
+	if count == 0 {
+		return fmt.Errorf("Constraint %d must have at least one variable.", index)
+	}
+
+	switch constraint.Type {
+	case AllDifferentConstraint:
+	case NotEqualOffsetConstraint:
+		if count != 2 {
+			return fmt.Errorf("Constraint %d (%s) must have exactly 2 variables, got %d.", index, constraint.Type, count)
+		}
+	case LinearSumConstraint:
+		if constraint.Coefficients == nil {
+			constraint.Coefficients = slices.Repeat([]int{1}, count)
+		}
+		if len(constraint.Coefficients) != count {
+			return fmt.Errorf("Constraint %d (%s) has %d coefficients, but %d variables.", index, constraint.Type, len(constraint.Coefficients), count)
+		}
+		if !slices.Contains(linearOperators, constraint.Operator) {
+			return fmt.Errorf("Constraint %d (%s) has an unknown operator \"%s\". Supported operators are %v.", index, constraint.Type, constraint.Operator, linearOperators)
+		}
+	case TableConstraint:
+		for _, tuple := range constraint.Tuples {
+			if len(tuple) != count {
+				return fmt.Errorf("Constraint %d (%s) has a tuple with %d values, but %d variables: %v.", index, constraint.Type, len(tuple), count, tuple)
+			}
+		}
+	default:
+		return fmt.Errorf("Constraint %d has an unknown type \"%s\". Supported types are \"%s\", \"%s\", \"%s\" and \"%s\".", index, constraint.Type, AllDifferentConstraint, LinearSumConstraint, TableConstraint, NotEqualOffsetConstraint)
+	}
+
+	return nil
+}
+
+// Declares the variables and constraints of a preset problem: for N-Queens, variable Qi holds the row of the queen
+// in column i, the queens are on different rows, and two queens i < j are on the same diagonal when their rows differ by j - i
+func PresetCSP(preset string, n int, blocked [][]int) ([]CSPVariable, []CSPConstraint, error) {
+	if preset != NQueensPreset {
+		return nil, nil, fmt.Errorf("Unknown preset \"%s\". Supported presets are \"%s\".", preset, NQueensPreset)
+	}
+	if n <= 0 {
+		return nil, nil, fmt.Errorf("Number of queens must be positive, got %d.", n)
+	}
+
+	// reusing the chessboard, so that blocked squares are validated the same way as for the N-Queens problem
+	board := chessboard{}
+	err := board.initialize(boardShape{rows: n, cols: n}, chessPieces[QueenPiece], blocked, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variables := make([]CSPVariable, n)
+	names := make([]string, n)
+	for col, queen := range board.queens {
+		names[col] = fmt.Sprintf("Q%d", col)
+		variables[col] = CSPVariable{Name: names[col], Domain: slices.Sorted(maps.Keys(queen.possibleValues))}
+	}
+
+	constraints := []CSPConstraint{{Type: AllDifferentConstraint, Variables: names}}
+	for i := range n {
+		for j := i + 1; j < n; j++ {
+			constraints = append(constraints,
+				CSPConstraint{Type: NotEqualOffsetConstraint, Variables: []string{names[i], names[j]}, Offset: j - i},
+				CSPConstraint{Type: NotEqualOffsetConstraint, Variables: []string{names[i], names[j]}, Offset: i - j},
+			)
+		}
+	}
+
+	return variables, constraints, nil
+}
+
+func (s *ConstraintSatisfactionSolver) Solve() {
+	search := newForwardChecking(s.problem, maxCSPIterations)
+	search.run()
+
+	s.problem = search.current
+	s.order = search.order
+	s.domains = search.domains
+	s.iterations = search.iterations
+	s.solvable = search.solvable
+	s.exhausted = search.exhausted
+}
+
+func (s *ConstraintSatisfactionSolver) FormatResult() CSPResult {
+	result := CSPResult{}
+
+	result.Iterations = s.iterations
+	result.Solution = make([]CSPResultVariable, 0, len(s.names))
+	result.FormattedOutput = ""
+
+	if !s.solvable {
+		result.Message = "No solution"
+		if s.exhausted {
+			result.Message = fmt.Sprintf("No solution found within %d iterations", maxCSPIterations)
+		}
+		return result
+	}
+
+	result.Message = "Solution found"
+	result.Assignment = make(map[string]int, len(s.names))
+	for index := range len(s.names) {
+		variable := s.order[index]
+		domain := slices.Sorted(maps.Keys(s.domains[index]))
+		value := s.problem.values[variable]
+
+		result.Assignment[s.names[variable]] = value
+		result.Solution = append(result.Solution, CSPResultVariable{Name: s.names[variable], Value: value, Domain: domain})
+		result.FormattedOutput += fmt.Sprintf("Variable %s: %v -> %d\n", s.names[variable], domain, value)
+	}
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type CSPResult struct {
+	Message         string              `json:"message"`
+	Iterations      int                 `json:"iterations"`
+	Assignment      map[string]int      `json:"assignment,omitempty"`
+	Solution        []CSPResultVariable `json:"solution"`
+	FormattedOutput string              `json:"formatted_output"`
+}
+
+type CSPResultVariable struct {
+	Name   string `json:"name"`
+	Value  int    `json:"value"`
+	Domain []int  `json:"domain"`
+}
@@ -0,0 +1,197 @@
+package solvers
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestConstraintSatisfaction(t *testing.T) {
+	type Test struct {
+		variables        []CSPVariable
+		constraints      []CSPConstraint
+		expectedSolvable bool
+	}
+
+	digits := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	queens, queensConstraints, err := PresetCSP(NQueensPreset, 8, [][]int{{0, 0}, {3, 4}})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	tests := []Test{
+		{
+			// SEND + MORE = MONEY, with the carries written out column by column
+			variables: []CSPVariable{
+				{Name: "S", Range: []int{1, 9}}, {Name: "E", Domain: digits}, {Name: "N", Domain: digits}, {Name: "D", Domain: digits},
+				{Name: "M", Range: []int{1, 9}}, {Name: "O", Domain: digits}, {Name: "R", Domain: digits}, {Name: "Y", Domain: digits},
+			},
+			constraints: []CSPConstraint{
+				{Type: AllDifferentConstraint, Variables: []string{"S", "E", "N", "D", "M", "O", "R", "Y"}},
+				{
+					Type:         LinearSumConstraint,
+					Variables:    []string{"S", "E", "N", "D", "M", "O", "R", "Y"},
+					Coefficients: []int{1000, 91, -90, 1, -9000, -900, 10, -1},
+					Operator:     "=",
+					Value:        0,
+				},
+			},
+			expectedSolvable: true,
+		},
+		{
+			variables: []CSPVariable{{Name: "x", Range: []int{-3, 3}}, {Name: "y", Range: []int{-3, 3}}, {Name: "z", Domain: []int{-1, 2}}},
+			constraints: []CSPConstraint{
+				{Type: TableConstraint, Variables: []string{"x", "y"}, Tuples: [][]int{{-1, -2}, {2, 3}, {3, -3}}},
+				{Type: NotEqualOffsetConstraint, Variables: []string{"y", "x"}, Offset: -1},
+				{Type: LinearSumConstraint, Variables: []string{"x", "z"}, Operator: "<", Value: 2},
+			},
+			expectedSolvable: true,
+		},
+		{
+			variables:        queens,
+			constraints:      queensConstraints,
+			expectedSolvable: true,
+		},
+		{
+			variables: []CSPVariable{{Name: "a", Range: []int{1, 2}}, {Name: "b", Range: []int{1, 2}}, {Name: "c", Range: []int{1, 2}}},
+			constraints: []CSPConstraint{
+				{Type: AllDifferentConstraint, Variables: []string{"a", "b", "c"}},
+			},
+			expectedSolvable: false,
+		},
+		{
+			variables: []CSPVariable{{Name: "a", Range: []int{0, 5}}, {Name: "b", Range: []int{0, 5}}},
+			constraints: []CSPConstraint{
+				{Type: LinearSumConstraint, Variables: []string{"a", "b"}, Coefficients: []int{2, 2}, Operator: "=", Value: 7},
+			},
+			expectedSolvable: false,
+		},
+	}
+
+	for testCount, test := range tests {
+		solver := ConstraintSatisfactionSolver{}
+		err := solver.Initialize(test.variables, test.constraints)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if solver.solvable != test.expectedSolvable {
+			t.Errorf("[Test %d] The solvability does not match the one expected.\nActual: %v\nExpected: %v", testCount+1, solver.solvable, test.expectedSolvable)
+		}
+
+		// validating that the assignment satisfies every constraint
+		if solver.solvable {
+			for _, variable := range test.variables {
+				if !containsValue(variable, result.Assignment[variable.Name]) {
+					t.Errorf("[Test %d] Variable %s was assigned %d, which is not in its domain.", testCount+1, variable.Name, result.Assignment[variable.Name])
+				}
+			}
+			for i, constraint := range test.constraints {
+				if !satisfies(constraint, result.Assignment) {
+					t.Errorf("[Test %d] Constraint %d (%s) is not satisfied by %v.", testCount+1, i, constraint.Type, result.Assignment)
+				}
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that invalid declarations are rejected
+	solver := ConstraintSatisfactionSolver{}
+	err = solver.Initialize([]CSPVariable{{Name: "x", Range: []int{0, 1}}}, []CSPConstraint{{Type: AllDifferentConstraint, Variables: []string{"x", "y"}}})
+	if err == nil {
+		t.Errorf("Expected constraints over unknown variables to be rejected.")
+	}
+	err = solver.Initialize([]CSPVariable{{Name: "x", Range: []int{0, 1}}}, []CSPConstraint{{Type: LinearSumConstraint, Variables: []string{"x"}, Operator: "=="}})
+	if err == nil {
+		t.Errorf("Expected unknown operators to be rejected.")
+	}
+	err = solver.Initialize([]CSPVariable{{Name: "x", Range: []int{math.MinInt + 1, math.MaxInt}}}, nil)
+	if err == nil {
+		t.Errorf("Expected ranges with too many values to be rejected.")
+	}
+	err = solver.Initialize([]CSPVariable{{Name: "x", Domain: []int{0, math.MinInt}}}, nil)
+	if err == nil {
+		t.Errorf("Expected domains containing the unassigned marker to be rejected.")
+	}
+
+	// validating that ranges ending at the largest integer are built without overflowing
+	err = solver.Initialize([]CSPVariable{{Name: "x", Range: []int{math.MaxInt - 2, math.MaxInt}}}, nil)
+	if err != nil {
+		t.Errorf("%s", err)
+	} else if len(solver.problem.domains[0]) != 3 {
+		t.Errorf("The size of the domain does not match the one expected.\nActual: %d\nExpected: %d", len(solver.problem.domains[0]), 3)
+	}
+}
+
+func containsValue(variable CSPVariable, value int) bool {
+	if variable.Range != nil {
+		return variable.Range[0] <= value && value <= variable.Range[1]
+	}
+	for _, allowed := range variable.Domain {
+		if allowed == value {
+			return true
+		}
+	}
+	return false
+}
+
+func satisfies(constraint CSPConstraint, assignment map[string]int) bool {
+	values := make([]int, len(constraint.Variables))
+	for i, name := range constraint.Variables {
+		values[i] = assignment[name]
+	}
+
+	switch constraint.Type {
+	case AllDifferentConstraint:
+		seen := make(map[int]bool)
+		for _, value := range values {
+			if seen[value] {
+				return false
+			}
+			seen[value] = true
+		}
+		return true
+	case NotEqualOffsetConstraint:
+		return values[0] != values[1]+constraint.Offset
+	case LinearSumConstraint:
+		sum := 0
+		for i, value := range values {
+			coefficient := 1
+			if constraint.Coefficients != nil {
+				coefficient = constraint.Coefficients[i]
+			}
+			sum += coefficient * value
+		}
+		switch constraint.Operator {
+		case "=":
+			return sum == constraint.Value
+		case "!=":
+			return sum != constraint.Value
+		case "<":
+			return sum < constraint.Value
+		case "<=":
+			return sum <= constraint.Value
+		case ">":
+			return sum > constraint.Value
+		default:
+			return sum >= constraint.Value
+		}
+	default:
+		for _, tuple := range constraint.Tuples {
+			if fmt.Sprint(tuple) == fmt.Sprint(values) {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,331 @@
+package solvers
+
+import (
+	"maps"
+	"slices"
+)
+
+// Represents the values a variable of a constraint satisfaction problem can still take
+type cspDomain = map[int]bool
+
+// Represents the state of a constraint satisfaction problem that can be searched with forward checking,
+// where variables are numbered from 0 and unassigned variables keep the values that are still consistent
+// with the assigned ones
+type cspState[S any] interface {
+	variableCount() int
+	isAssigned(variable int) bool
+	domain(variable int) cspDomain
+	// assigns the value to the variable, removing the values it conflicts with from the domains of the unassigned variables
+	assign(variable int, value int)
+	cloneDeep() S
+}
+
+// Represents a state whose constraints all involve two variables, so that arc consistency can be enforced
+// with the AC-3 algorithm after every assignment
+type binaryConstraints interface {
+	value(variable int) int
+	constrained(variable int) []int
+	compatible(variable int, value int, other int, otherValue int) bool
+}
+
+// Searches for a complete assignment with the Forward Checking algorithm, always selecting the unassigned variable
+// with the fewest remaining values (MRV sorting) and trying its values in increasing order; variables that are
+// already assigned in the initial state are kept as they are
+type forwardChecking[S cspState[S]] struct {
+	current      S
+	limit        int
+	maxSolutions int
+	solutions    []S
+	order        map[int]int
+	domains      map[int]cspDomain
+	iterations   int
+	solvable     bool
+	exhausted    bool
+	trace        *cspTrace
+}
+
+// Kinds of events recorded while tracing the search
+const (
+	selectEvent    = "select"
+	assignEvent    = "assign"
+	pruneEvent     = "prune"
+	backtrackEvent = "backtrack"
+)
+
+// Represents a step of the search: selecting a variable (along with its domain), assigning a value to it,
+// pruning the values it conflicts with from the other domains, or undoing an assignment and restoring the pruned values;
+// when an earlier assignment is undone, its value is also removed from the domain of its variable, so that the next
+// values are tried
+type cspEvent struct {
+	kind     string
+	variable int
+	value    int
+	domain   []int
+	changes  map[int][]int
+	excluded bool
+}
+
+// Represents the events recorded during the search, up to the given limit
+type cspTrace struct {
+	events    []cspEvent
+	limit     int
+	truncated bool
+}
+
+func (t *cspTrace) record(event cspEvent) {
+	if len(t.events) == t.limit {
+		t.truncated = true
+		return
+	}
+
+	t.events = append(t.events, event)
+}
+
+// Prepares a search starting from the given state; a positive limit stops the search after that many iterations
+func newForwardChecking[S cspState[S]](state S, limit int) *forwardChecking[S] {
+	return &forwardChecking[S]{
+		current:      state,
+		limit:        limit,
+		maxSolutions: 1,
+		solutions:    make([]S, 0),
+		order:        make(map[int]int),
+		domains:      make(map[int]cspDomain),
+	}
+}
+
+func (f *forwardChecking[S]) run() {
+	n := 0
+	for variable := range f.current.variableCount() {
+		if !f.current.isAssigned(variable) {
+			n++
+		}
+	}
+
+	beforeAssignment := make(map[int]S)
+	values := make(map[int]int)
+	assigned := 0
+	if !propagate(f.current, -1) {
+		assigned = -1
+	}
+
+	var order map[int]int
+	var domains map[int]cspDomain
+
+	for assigned >= 0 {
+		if assigned == n {
+			// keeping the first solution along with the order in which its variables were processed
+			if len(f.solutions) == 0 {
+				order, domains = maps.Clone(f.order), maps.Clone(f.domains)
+			}
+			f.solutions = append(f.solutions, f.current)
+			if len(f.solutions) >= f.maxSolutions {
+				break
+			}
+
+			// backtracking from the last assignment, to look for another solution
+			assigned--
+			if assigned >= 0 {
+				f.traceBacktrack(f.order[assigned], values[assigned], beforeAssignment[assigned], f.current)
+				f.current = beforeAssignment[assigned].cloneDeep()
+			}
+			continue
+		}
+
+		if f.limit > 0 && f.iterations >= f.limit {
+			f.exhausted = true
+			break
+		}
+
+		variable := f.selectVariable()
+		if f.tracing() {
+			f.trace.record(cspEvent{kind: selectEvent, variable: variable, domain: slices.Sorted(maps.Keys(f.current.domain(variable)))})
+		}
+		value, next, found := f.selectValue(variable)
+
+		if !found {
+			assigned--
+			if assigned >= 0 {
+				state, exists := beforeAssignment[assigned]
+				if exists {
+					f.traceBacktrack(f.order[assigned], values[assigned], state, f.current)
+					f.current = state.cloneDeep()
+				}
+			}
+		} else {
+			// saving the order in which the variables were processed + their domains at the time of selection
+			f.order[assigned] = variable
+			f.domains[assigned] = maps.Clone(f.current.domain(variable))
+
+			// removing the selected value from the variable's domain, to be able to backtrack if needed
+			delete(f.current.domain(variable), value)
+			beforeAssignment[assigned] = f.current.cloneDeep()
+			values[assigned] = value
+
+			assigned++
+			f.current = next
+		}
+
+		f.iterations++
+	}
+
+	f.solvable = len(f.solutions) > 0
+	if f.solvable {
+		f.current = f.solutions[0]
+		f.order, f.domains = order, domains
+	}
+}
+
+// Selects the unassigned variable with the smallest domain, breaking ties by the smallest index
+func (f *forwardChecking[S]) selectVariable() int {
+	selected := -1
+	for variable := range f.current.variableCount() {
+		if f.current.isAssigned(variable) {
+			continue
+		}
+
+		if selected == -1 || len(f.current.domain(variable)) < len(f.current.domain(selected)) {
+			selected = variable
+		}
+	}
+
+	return selected
+}
+
+// Selects the smallest value that leaves every unassigned variable with at least one possible value,
+// returning the state obtained after assigning it (or false if there is no such value)
+func (f *forwardChecking[S]) selectValue(variable int) (int, S, bool) {
+	for _, value := range slices.Sorted(maps.Keys(f.current.domain(variable))) {
+		next := f.current.cloneDeep()
+		next.assign(variable, value)
+
+		emptyDomain := !propagate(next, variable)
+		for other := 0; other < next.variableCount() && !emptyDomain; other++ {
+			emptyDomain = !next.isAssigned(other) && len(next.domain(other)) == 0
+		}
+
+		// a value that empties some domain is undone right away, restoring the values it pruned
+		if f.tracing() {
+			pruned := domainChanges(f.current, next, variable)
+			f.trace.record(cspEvent{kind: assignEvent, variable: variable, value: value})
+			f.trace.record(cspEvent{kind: pruneEvent, variable: variable, value: value, changes: pruned})
+			if emptyDomain {
+				f.trace.record(cspEvent{kind: backtrackEvent, variable: variable, value: value, changes: pruned})
+			}
+		}
+
+		if !emptyDomain {
+			return value, next, true
+		}
+	}
+
+	var none S
+	return 0, none, false
+}
+
+func (f *forwardChecking[S]) tracing() bool {
+	return f.trace != nil && !f.trace.truncated
+}
+
+// Records the undoing of an assignment, going back from the current state to the one before it
+func (f *forwardChecking[S]) traceBacktrack(variable int, value int, previous S, current S) {
+	if f.tracing() {
+		f.trace.record(cspEvent{kind: backtrackEvent, variable: variable, value: value, changes: domainChanges(previous, current, variable), excluded: true})
+	}
+}
+
+// Returns the values that are in the domains of the first state but not in the ones of the second state,
+// for every variable that is unassigned in the first state, except for the given one
+func domainChanges[S cspState[S]](from S, to S, except int) map[int][]int {
+	changes := make(map[int][]int)
+	for variable := range from.variableCount() {
+		if variable == except || from.isAssigned(variable) {
+			continue
+		}
+
+		for _, value := range slices.Sorted(maps.Keys(from.domain(variable))) {
+			if !to.domain(variable)[value] {
+				changes[variable] = append(changes[variable], value)
+			}
+		}
+	}
+
+	return changes
+}
+
+// Enforces arc consistency on states with binary constraints after the given variable was assigned
+// (or on the whole state if the variable is -1), returning false if a domain becomes empty; other states are left unchanged
+func propagate[S cspState[S]](state S, variable int) bool {
+	constraints, ok := any(state).(binaryConstraints)
+	if !ok {
+		return true
+	}
+
+	// only the arcs pointing to the assigned variable or to the variables forward checking pruned need to be checked
+	changed := []int{variable}
+	if variable == -1 {
+		changed = make([]int, state.variableCount())
+		for i := range changed {
+			changed[i] = i
+		}
+	} else {
+		changed = append(changed, constraints.constrained(variable)...)
+	}
+
+	return arcConsistency(state, constraints, changed)
+}
+
+// Runs the AC-3 algorithm: every arc (x, y) removes the values of x that no value of y is compatible with,
+// and arcs pointing to x are checked again whenever its domain shrinks; assigned variables only keep their value
+func arcConsistency[S cspState[S]](state S, constraints binaryConstraints, changed []int) bool {
+	// checks whether some value of the other variable is compatible with the value of the variable
+	supported := func(variable int, value int, other int) bool {
+		if state.isAssigned(other) {
+			return constraints.compatible(variable, value, other, constraints.value(other))
+		}
+
+		for otherValue := range state.domain(other) {
+			if constraints.compatible(variable, value, other, otherValue) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	queue := make([][2]int, 0)
+	for _, other := range changed {
+		for _, variable := range constraints.constrained(other) {
+			if !state.isAssigned(variable) {
+				queue = append(queue, [2]int{variable, other})
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		variable, other := queue[0][0], queue[0][1]
+		queue = queue[1:]
+
+		revised := false
+		for value := range state.domain(variable) {
+			if !supported(variable, value, other) {
+				delete(state.domain(variable), value)
+				revised = true
+			}
+		}
+
+		if !revised {
+			continue
+		}
+		if len(state.domain(variable)) == 0 {
+			return false
+		}
+
+		for _, neighbor := range constraints.constrained(variable) {
+			if neighbor != other && !state.isAssigned(neighbor) {
+				queue = append(queue, [2]int{neighbor, variable})
+			}
+		}
+	}
+
+	return true
+}
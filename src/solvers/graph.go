@@ -0,0 +1,789 @@
+package solvers
+
+import (
+	"container/heap"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// Represents a node identifier as given in the request, either an integer index or a string name
+type NodeID struct {
+	name  string
+	index int
+	named bool
+}
+
+// Represents the lack of a node, such as the next hop towards an unreachable node
+var noNode = NodeID{index: -1}
+
+func (id *NodeID) UnmarshalJSON(data []byte) error {
+	err := json.Unmarshal(data, &id.index)
+	if err == nil {
+		id.name = ""
+		id.named = false
+		return nil
+	}
+
+	err = json.Unmarshal(data, &id.name)
+	if err != nil {
+		return fmt.Errorf("node %s must be either an integer or a string", data)
+	}
+	id.index = -1
+	id.named = true
+
+	return nil
+}
+
+func (id NodeID) MarshalJSON() ([]byte, error) {
+	if id.named {
+		return json.Marshal(id.name)
+	}
+
+	return json.Marshal(id.index)
+}
+
+func (id NodeID) String() string {
+	if id.named {
+		return id.name
+	}
+
+	return fmt.Sprintf("%d", id.index)
+}
+
+// Markers that can follow an edge in the request, overriding the orientation of the graph
+const (
+	ForwardDirection       = "->"
+	BackwardDirection      = "<-"
+	BidirectionalDirection = "<->"
+)
+
+// Represents an edge as given in the request, in a [start, end, weight] format,
+// optionally followed by one of the direction markers; the weight is also kept exactly as it was written,
+// so that it can be parsed without losing precision, and it can be replaced by a vector of weights
+// (such as [cost, time]), in which case the first one is used by the single-criterion algorithms
+type GraphEdge struct {
+	From      NodeID
+	To        NodeID
+	Weight    float64
+	Weights   []float64
+	Direction string
+	literal   string
+}
+
+func (e *GraphEdge) UnmarshalJSON(data []byte) error {
+	var group []json.RawMessage
+	err := json.Unmarshal(data, &group)
+	if err != nil || len(group) < 3 || len(group) > 4 {
+		return fmt.Errorf("edge %s is not in a [start, end, weight] format, optionally followed by a direction marker", data)
+	}
+
+	for i, field := range []any{&e.From, &e.To} {
+		err = json.Unmarshal(group[i], field)
+		if err != nil {
+			return fmt.Errorf("edge %s has an invalid value at position %d: %v", data, i, err)
+		}
+	}
+
+	weights := []json.Number{""}
+	err = json.Unmarshal(group[2], &weights[0])
+	if err != nil {
+		err = json.Unmarshal(group[2], &weights)
+		if err != nil || len(weights) == 0 {
+			return fmt.Errorf("edge %s has an invalid weight, which must be either a number or a non-empty array of numbers", data)
+		}
+
+		e.Weights = make([]float64, len(weights))
+	} else {
+		e.Weights = nil
+	}
+
+	// weights beyond the range of floating-point numbers become infinite, and are only accepted by the graphs
+	// that parse the literal with arbitrary precision
+	for i, weight := range weights {
+		value, err := weight.Float64()
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return fmt.Errorf("edge %s has an invalid weight: %v", data, err)
+		}
+
+		if e.Weights != nil {
+			e.Weights[i] = value
+		}
+	}
+	e.Weight, _ = weights[0].Float64()
+	e.literal = weights[0].String()
+
+	e.Direction = ""
+	if len(group) == 4 {
+		err = json.Unmarshal(group[3], &e.Direction)
+		if err != nil {
+			return fmt.Errorf("edge %s has an invalid direction marker: %v", data, err)
+		}
+	}
+
+	return nil
+}
+
+// Checks whether the weight was written as an integer, rather than as a decimal or in scientific notation
+func (e *GraphEdge) isInteger() bool {
+	if e.literal == "" {
+		return math.Trunc(e.Weight) == e.Weight
+	}
+
+	return !strings.ContainsAny(e.literal, ".eE")
+}
+
+func (e *GraphEdge) String() string {
+	if e.Direction == "" {
+		return fmt.Sprintf("[%s, %s]", e.From, e.To)
+	}
+
+	return fmt.Sprintf("[%s, %s, \"%s\"]", e.From, e.To, e.Direction)
+}
+
+// Represents an edge pointing to a given node, having a specified weight (or vector of weights);
+// reversed edges are the mirrored copies of undirected edges, travelled against their declared orientation
+type edge struct {
+	node     int
+	weight   float64
+	weights  []float64
+	literal  string
+	reversed bool
+}
+
+// Returns every weight of the edge, which is a single one unless a vector of weights was given
+func (e edge) criteria() []float64 {
+	if e.weights == nil {
+		return []float64{e.weight}
+	}
+
+	return e.weights
+}
+
+// Returns the weight of the edge as an arbitrary-precision number, parsed from the request when possible
+func (e edge) exactWeight() *big.Rat {
+	weight, ok := new(big.Rat).SetString(e.literal)
+	if !ok {
+		weight = new(big.Rat).SetFloat64(e.weight)
+	}
+
+	return weight
+}
+
+// The largest magnitude up to which integers can be represented exactly (and told apart) as floating-point numbers
+const maxExactInteger = 1<<53 - 1
+
+// Holds the settings used when building a graph from the edges given in a request; exact graphs
+// are searched with arbitrary-precision weights, so their weights are not limited to what a float can represent exactly
+type graphOptions struct {
+	directed      bool
+	allowNegative bool
+	exact         bool
+}
+
+// Represents a weighted graph, optionally allowing negative weights, whose edges can be either
+// directed or undirected (stored as a pair of opposite directed edges); nodes are stored as indices
+// in the interval [0, n), along with the identifiers they were given in the request
+type graph struct {
+	n                    int
+	edgeCount            int
+	adjacencyList        map[int][]edge
+	reverseAdjacencyList map[int][]edge
+	named                bool
+	labels               []NodeID
+	indices              map[string]int
+	criteria             int
+	integral             bool
+	overflowed           bool
+}
+
+func (g *graph) initialize(n int, edges []GraphEdge, options graphOptions) error {
+	err := g.initializeNodes(n, edges)
+	if err != nil {
+		return err
+	}
+	n = g.n
+
+	g.edgeCount = 0
+	g.criteria = 1
+	g.integral = true
+	g.overflowed = false
+	g.adjacencyList = make(map[int][]edge, n)
+	g.reverseAdjacencyList = make(map[int][]edge, n)
+
+	for i := range n {
+		g.adjacencyList[i] = make([]edge, 0, n-1)
+		g.reverseAdjacencyList[i] = make([]edge, 0)
+	}
+
+	for i, group := range edges {
+		from, err := g.resolve(group.From)
+		if err != nil {
+			return fmt.Errorf("%v Found in edge %s (weight %s).", err, group.String(), formatWeight(group.Weight))
+		}
+
+		to, err := g.resolve(group.To)
+		if err != nil {
+			return fmt.Errorf("%v Found in edge %s (weight %s).", err, group.String(), formatWeight(group.Weight))
+		}
+
+		// every edge must have as many weights as the first one
+		criteria := max(1, len(group.Weights))
+		if i == 0 {
+			g.criteria = criteria
+		} else if criteria != g.criteria {
+			return fmt.Errorf("Edge %s has %d weights, but the first edge has %d. All edges must have the same number of weights.", group.String(), criteria, g.criteria)
+		}
+
+		for _, weight := range append([]float64{group.Weight}, group.Weights...) {
+			if math.IsInf(weight, 0) && !options.exact {
+				return fmt.Errorf("Edge %s has a weight that exceeds the range of floating-point numbers.", group.String())
+			}
+			if weight < 0 && !options.allowNegative {
+				return fmt.Errorf("Edge %s has a negative weight: %s. Use the \"%s\" algorithm for graphs with negative weights.", group.String(), formatWeight(weight), BellmanFordAlgorithm)
+			}
+
+			if !group.isInteger() || math.Trunc(weight) != weight {
+				g.integral = false
+			} else if math.Abs(weight) > maxExactInteger && !options.exact {
+				return fmt.Errorf("Edge %s has a weight (%s) that can not be represented exactly. Integer weights must belong to the interval [-%d, %d].", group.String(), formatWeight(weight), int64(maxExactInteger), int64(maxExactInteger))
+			}
+		}
+
+		forward, backward := true, !options.directed
+		switch group.Direction {
+		case "":
+		case ForwardDirection:
+			forward, backward = true, false
+		case BackwardDirection:
+			forward, backward = false, true
+		case BidirectionalDirection:
+			forward, backward = true, true
+		default:
+			return fmt.Errorf("Edge %s has an unknown direction marker. Supported markers are \"%s\", \"%s\" and \"%s\".", group.String(), ForwardDirection, BackwardDirection, BidirectionalDirection)
+		}
+
+		if forward {
+			g.addEdge(from, to, edge{weight: group.Weight, weights: group.Weights, literal: group.literal})
+		}
+		if backward {
+			g.addEdge(to, from, edge{weight: group.Weight, weights: group.Weights, literal: group.literal, reversed: true})
+		}
+	}
+
+	return nil
+}
+
+// Assigns an index to every node; named nodes are numbered in the order in which they first appear,
+// while for integer nodes the number of nodes is inferred from the largest one if it is not given
+func (g *graph) initializeNodes(n int, edges []GraphEdge) error {
+	g.named = len(edges) > 0 && edges[0].From.named
+	g.indices = make(map[string]int)
+
+	largest := -1
+	for _, group := range edges {
+		for _, id := range []NodeID{group.From, group.To} {
+			if id.named != g.named {
+				return fmt.Errorf("Edge %s mixes integer and string nodes. All nodes must be given in the same format.", group.String())
+			}
+
+			if id.named {
+				if _, exists := g.indices[id.name]; !exists {
+					g.indices[id.name] = len(g.indices)
+				}
+			} else {
+				largest = max(largest, id.index)
+			}
+		}
+	}
+
+	// named graphs can also have nodes that appear in no edge, which have no name and are referred to by their index
+	if g.named {
+		if n != 0 && n < len(g.indices) {
+			return fmt.Errorf("Number of nodes (%d) is smaller than the number of distinct node names (%d).", n, len(g.indices))
+		}
+		n = max(n, len(g.indices))
+	} else if n == 0 {
+		n = largest + 1
+	}
+
+	if n <= 0 {
+		return fmt.Errorf("Number of nodes must be positive, got %d.", n)
+	}
+
+	g.n = n
+	g.labels = make([]NodeID, n)
+	for i := range n {
+		g.labels[i] = NodeID{index: i}
+	}
+	for name, index := range g.indices {
+		g.labels[index] = NodeID{name: name, index: index, named: true}
+	}
+
+	return nil
+}
+
+// Finds the index of the node with the given identifier
+func (g *graph) resolve(id NodeID) (int, error) {
+	if g.named {
+		if !id.named {
+			return -1, fmt.Errorf("Node %s is an integer, but the graph uses string node names.", id)
+		}
+
+		index, exists := g.indices[id.name]
+		if !exists {
+			return -1, fmt.Errorf("Node \"%s\" does not appear in any edge.", id.name)
+		}
+
+		return index, nil
+	}
+
+	if id.named {
+		return -1, fmt.Errorf("Node \"%s\" is a string, but the graph uses integer nodes.", id.name)
+	}
+	if id.index < 0 || id.index >= g.n {
+		return -1, fmt.Errorf("Node %d is out of bounds. Node values belong to the interval [0, %d).", id.index, g.n)
+	}
+
+	return id.index, nil
+}
+
+// Converts a sequence of node indices into the identifiers given in the request
+func (g *graph) labelPath(path []int) []NodeID {
+	labels := make([]NodeID, len(path))
+	for i, node := range path {
+		labels[i] = g.labels[node]
+	}
+
+	return labels
+}
+
+// Adds an edge going from one node to another, with the given weight and orientation
+func (g *graph) addEdge(from int, to int, e edge) {
+	g.edgeCount++
+
+	e.node = to
+	g.adjacencyList[from] = append(g.adjacencyList[from], e)
+
+	e.node = from
+	g.reverseAdjacencyList[to] = append(g.reverseAdjacencyList[to], e)
+}
+
+// Adds a weight to a distance, recording whether the sum overflowed or, for integer weights,
+// grew beyond the range in which it can be represented exactly
+func (g *graph) extend(distance float64, weight float64) float64 {
+	sum := distance + weight
+	if math.IsInf(sum, 0) || (g.integral && math.Abs(sum) > maxExactInteger) {
+		g.overflowed = true
+	}
+
+	return sum
+}
+
+// Returns an error if any of the distances computed on the graph could not be represented exactly
+func (g *graph) checkOverflow() error {
+	if !g.overflowed {
+		return nil
+	}
+
+	if g.integral {
+		return fmt.Errorf("Distances exceed %d and can no longer be represented exactly.", int64(maxExactInteger))
+	}
+
+	return errors.New("Distances exceed the range of floating-point numbers.")
+}
+
+// Returns the cheapest edge going from one node to another
+func (g *graph) cheapestEdge(from int, to int) (edge, bool) {
+	cheapest := edge{node: to, weight: math.Inf(1)}
+	found := false
+
+	for _, edge := range g.adjacencyList[from] {
+		if edge.node == to && edge.weight < cheapest.weight {
+			cheapest = edge
+			found = true
+		}
+	}
+
+	return cheapest, found
+}
+
+// Returns the smallest weight among the edges going from one node to another
+func (g *graph) minWeight(from int, to int) float64 {
+	edge, _ := g.cheapestEdge(from, to)
+	return edge.weight
+}
+
+// Represents the distances and predecessors computed by a single-source search
+type shortestPathTree struct {
+	distances []float64
+	previous  []int
+}
+
+func newShortestPathTree(n int, source int) shortestPathTree {
+	tree := shortestPathTree{
+		distances: make([]float64, n),
+		previous:  make([]int, n),
+	}
+
+	for i := range n {
+		tree.distances[i] = math.Inf(1)
+		tree.previous[i] = -1
+	}
+	tree.distances[source] = 0
+
+	return tree
+}
+
+// Creates a tree in which every node starts at distance 0, as if an extra node was connected to all of them
+func newPotentialsTree(n int) shortestPathTree {
+	tree := shortestPathTree{
+		distances: make([]float64, n),
+		previous:  make([]int, n),
+	}
+
+	for i := range n {
+		tree.previous[i] = -1
+	}
+
+	return tree
+}
+
+// Builds the path from the root of the tree to the given node, or an empty path if the node is not reachable
+func (t *shortestPathTree) pathTo(node int) []int {
+	path := make([]int, 0)
+	if math.IsInf(t.distances[node], 1) {
+		return path
+	}
+
+	for current := node; current > -1; current = t.previous[current] {
+		path = append(path, current)
+	}
+	slices.Reverse(path)
+
+	return path
+}
+
+// Represents the nodes and edges that a search is not allowed to use
+type searchRestrictions struct {
+	nodes map[int]bool
+	edges map[[2]int]bool
+}
+
+func (r *searchRestrictions) allows(from int, to int) bool {
+	return r == nil || (!r.nodes[to] && !r.edges[[2]int{from, to}])
+}
+
+// Runs Dijkstra's algorithm from the given source node, assuming all weights are positive;
+// if a target node is given (not -1), the search stops as soon as the target is settled
+func (g *graph) dijkstra(tree *shortestPathTree, source int, target int, restrictions *searchRestrictions) int {
+	pq := make(utils.PriorityQueue[int, float64], 0, g.n)
+	heap.Init(&pq)
+	settled := 0
+
+	// initialize the heap with the source node, whose minimum distance is 0
+	item := utils.Item[int, float64]{
+		Value:    source,
+		Priority: -tree.distances[source],
+	}
+	heap.Push(&pq, &item)
+
+	for pq.Len() > 0 {
+		current := heap.Pop(&pq).(*utils.Item[int, float64])
+		node := current.Value
+		distance := current.Priority * -1
+
+		// if the node has already been processed, skip it
+		if distance > tree.distances[node] {
+			continue
+		}
+
+		settled++
+		if node == target {
+			break
+		}
+
+		// add all adjacent neighbors to the priority queue
+		for _, edge := range g.adjacencyList[node] {
+			if !restrictions.allows(node, edge.node) {
+				continue
+			}
+
+			if distance := g.extend(tree.distances[node], edge.weight); distance < tree.distances[edge.node] {
+				tree.distances[edge.node] = distance
+				tree.previous[edge.node] = node
+				newItem := utils.Item[int, float64]{
+					Value:    edge.node,
+					Priority: -tree.distances[edge.node],
+				}
+				heap.Push(&pq, &newItem)
+			}
+		}
+	}
+
+	return settled
+}
+
+// Represents a distance found by an arbitrary-precision search, waiting in the priority queue
+type exactLabel struct {
+	node     int
+	distance *big.Rat
+}
+
+// Runs Dijkstra's algorithm with arbitrary-precision distances, storing their floating-point approximations
+// in the tree (kept within the range of floating-point numbers, so that they never stand for unreachable nodes);
+// the heap is ordered by the approximations, so a node is expanded again whenever its exact distance
+// still improves, and the search only stops once every remaining node is strictly farther away than the target
+func (g *graph) exactDijkstra(tree *shortestPathTree, source int, target int) ([]*big.Rat, int) {
+	distances := make([]*big.Rat, g.n)
+	distances[source] = new(big.Rat)
+
+	pq := make(utils.PriorityQueue[exactLabel, float64], 0, g.n)
+	heap.Init(&pq)
+	heap.Push(&pq, &utils.Item[exactLabel, float64]{Value: exactLabel{node: source, distance: distances[source]}})
+	settled := 0
+
+	for pq.Len() > 0 {
+		current := heap.Pop(&pq).(*utils.Item[exactLabel, float64])
+		node := current.Value.node
+
+		// if a shorter distance has been found since the node was added, skip it
+		if current.Value.distance.Cmp(distances[node]) > 0 {
+			continue
+		}
+		if target != -1 && distances[target] != nil && -current.Priority > tree.distances[target] {
+			break
+		}
+		settled++
+
+		for _, edge := range g.adjacencyList[node] {
+			distance := new(big.Rat).Add(distances[node], edge.exactWeight())
+			if distances[edge.node] == nil || distance.Cmp(distances[edge.node]) < 0 {
+				distances[edge.node] = distance
+				approximation, _ := distance.Float64()
+				tree.distances[edge.node] = max(-math.MaxFloat64, min(math.MaxFloat64, approximation))
+				tree.previous[edge.node] = node
+				heap.Push(&pq, &utils.Item[exactLabel, float64]{
+					Value:    exactLabel{node: edge.node, distance: distance},
+					Priority: -tree.distances[edge.node],
+				})
+			}
+		}
+	}
+
+	return distances, settled
+}
+
+// Represents one side of a bidirectional search, either expanding forwards from the source
+// or backwards from the target over the reversed edges
+type searchFrontier struct {
+	tree          shortestPathTree
+	adjacencyList map[int][]edge
+	heap          utils.PriorityQueue[int, float64]
+	settled       []bool
+}
+
+func newSearchFrontier(n int, start int, adjacencyList map[int][]edge) *searchFrontier {
+	frontier := searchFrontier{
+		tree:          newShortestPathTree(n, start),
+		adjacencyList: adjacencyList,
+		heap:          make(utils.PriorityQueue[int, float64], 0, n),
+		settled:       make([]bool, n),
+	}
+
+	heap.Init(&frontier.heap)
+	heap.Push(&frontier.heap, &utils.Item[int, float64]{Value: start, Priority: 0})
+
+	return &frontier
+}
+
+// Returns the smallest distance still waiting in the priority queue
+func (f *searchFrontier) peek() float64 {
+	return -f.heap[0].Priority
+}
+
+// Settles the closest node of the frontier, relaxing its edges and updating the best known meeting point
+func (f *searchFrontier) step(g *graph, other *searchFrontier, best *float64, meeting *int) bool {
+	current := heap.Pop(&f.heap).(*utils.Item[int, float64])
+	node := current.Value
+	if -current.Priority > f.tree.distances[node] || f.settled[node] {
+		return false
+	}
+	f.settled[node] = true
+
+	for _, edge := range f.adjacencyList[node] {
+		if distance := g.extend(f.tree.distances[node], edge.weight); distance < f.tree.distances[edge.node] {
+			f.tree.distances[edge.node] = distance
+			f.tree.previous[edge.node] = node
+			heap.Push(&f.heap, &utils.Item[int, float64]{Value: edge.node, Priority: -f.tree.distances[edge.node]})
+		}
+
+		// checking whether the two searches have met at this neighbor
+		if !math.IsInf(other.tree.distances[edge.node], 1) && !math.IsInf(f.tree.distances[edge.node], 1) {
+			total := g.extend(f.tree.distances[edge.node], other.tree.distances[edge.node])
+			if total < *best {
+				*best = total
+				*meeting = edge.node
+			}
+		}
+	}
+
+	return true
+}
+
+// Runs Dijkstra's algorithm simultaneously from the source and, over the reversed edges, from the target,
+// stopping once the two searches can no longer improve the best path found; the path is stored in the given tree
+func (g *graph) bidirectionalDijkstra(tree *shortestPathTree, source int, target int) int {
+	if source == target {
+		return 1
+	}
+
+	forward := newSearchFrontier(g.n, source, g.adjacencyList)
+	backward := newSearchFrontier(g.n, target, g.reverseAdjacencyList)
+	best := math.Inf(1)
+	meeting := -1
+	settled := 0
+
+	for forward.heap.Len() > 0 && backward.heap.Len() > 0 {
+		if !math.IsInf(best, 1) && forward.peek()+backward.peek() >= best {
+			break
+		}
+
+		// expanding the smaller frontier first keeps both searches balanced
+		if forward.heap.Len() <= backward.heap.Len() {
+			if forward.step(g, backward, &best, &meeting) {
+				settled++
+			}
+		} else {
+			if backward.step(g, forward, &best, &meeting) {
+				settled++
+			}
+		}
+	}
+
+	if meeting == -1 {
+		return settled
+	}
+
+	// joining the two halves of the path at the meeting node
+	for node := meeting; node != source; node = forward.tree.previous[node] {
+		tree.distances[node] = forward.tree.distances[node]
+		tree.previous[node] = forward.tree.previous[node]
+	}
+	for node := meeting; node != target; node = backward.tree.previous[node] {
+		next := backward.tree.previous[node]
+		tree.distances[next] = g.extend(tree.distances[node], g.minWeight(node, next))
+		tree.previous[next] = node
+	}
+
+	return settled
+}
+
+// Runs the Bellman-Ford algorithm starting from every node whose distance in the tree is already known,
+// returning an error if a negative cycle can be reached from any of them
+func (g *graph) bellmanFord(tree *shortestPathTree) error {
+	n := g.n
+
+	// relax every edge n - 1 times, stopping early if nothing changes
+	lastUpdated := -1
+	for range n {
+		lastUpdated = -1
+		for node := range n {
+			if math.IsInf(tree.distances[node], 1) {
+				continue
+			}
+
+			for _, edge := range g.adjacencyList[node] {
+				if distance := g.extend(tree.distances[node], edge.weight); distance < tree.distances[edge.node] {
+					tree.distances[edge.node] = distance
+					tree.previous[edge.node] = node
+					lastUpdated = edge.node
+				}
+			}
+		}
+
+		if lastUpdated == -1 {
+			return nil
+		}
+	}
+
+	// an edge could still be relaxed on the n-th pass, so a negative cycle is reachable;
+	// walking back n steps guarantees that we end up on the cycle itself
+	node := lastUpdated
+	for range n {
+		node = tree.previous[node]
+	}
+
+	cycle := []int{node}
+	for current := tree.previous[node]; ; current = tree.previous[current] {
+		cycle = append(cycle, current)
+		if current == node {
+			break
+		}
+	}
+	slices.Reverse(cycle)
+
+	weight := 0.0
+	for i := 1; i < len(cycle); i++ {
+		weight += g.minWeight(cycle[i-1], cycle[i])
+	}
+
+	return &NegativeCycleError{Cycle: g.labelPath(cycle), Weight: weight}
+}
+
+// Represents a negative cycle in the graph, which makes the shortest paths undefined
+type NegativeCycleError struct {
+	Cycle  []NodeID `json:"cycle"`
+	Weight float64  `json:"weight"`
+}
+
+func (e *NegativeCycleError) Error() string {
+	return fmt.Sprintf("Graph contains a negative cycle: %v (total weight %s).", e.Cycle, formatWeight(e.Weight))
+}
+
+// Formats a weight without trailing zeros, switching to scientific notation only for very large magnitudes
+func formatWeight(weight float64) string {
+	if math.Abs(weight) >= 1e21 {
+		return strconv.FormatFloat(weight, 'g', -1, 64)
+	}
+
+	return strconv.FormatFloat(weight, 'f', -1, 64)
+}
+
+// Formats an arbitrary-precision number as an exact decimal, or as a fraction if it has no finite decimal expansion
+func formatExact(number *big.Rat) string {
+	if number.IsInt() {
+		return number.Num().String()
+	}
+
+	// a fraction has a finite decimal expansion only if its denominator is made up of factors of 2 and 5
+	denominator := new(big.Int).Set(number.Denom())
+	digits := 0
+	for _, factor := range []int64{2, 5} {
+		count := 0
+		divisor := big.NewInt(factor)
+		remainder := new(big.Int)
+		for {
+			quotient, _ := new(big.Int).QuoRem(denominator, divisor, remainder)
+			if remainder.Sign() != 0 {
+				break
+			}
+			denominator = quotient
+			count++
+		}
+		digits = max(digits, count)
+	}
+
+	if denominator.Cmp(big.NewInt(1)) != 0 {
+		return number.RatString()
+	}
+
+	return number.FloatString(digits)
+}
@@ -0,0 +1,480 @@
+package solvers
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// Handles the problem solving logic
+type GraphAnalysisSolver struct {
+	graph              graph
+	directed           bool
+	links              [][2]int
+	arcs               [][]int
+	components         [][]int
+	componentOf        []int
+	order              []int
+	cycle              []int
+	bridges            [][2]int
+	articulationPoints []int
+}
+
+func (s *GraphAnalysisSolver) Initialize(n int, edges []GraphEdge, directed bool) error {
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{directed: directed, allowNegative: true})
+	if err != nil {
+		return err
+	}
+	s.directed = directed
+
+	// every edge is also kept as a single undirected link, regardless of its orientation, while only the directed edges
+	// are kept as arcs; undirected edges of graphs that also have directed edges can still be crossed both ways,
+	// but a single one of them never makes up a cycle on its own
+	s.links = make([][2]int, len(edges))
+	s.arcs = make([][]int, s.graph.n)
+	for i, group := range edges {
+		from, _ := s.graph.resolve(group.From)
+		to, _ := s.graph.resolve(group.To)
+		s.links[i] = [2]int{from, to}
+
+		switch {
+		case group.Direction == BackwardDirection:
+			s.arcs[to] = append(s.arcs[to], from)
+		case group.Direction == ForwardDirection || (group.Direction == "" && directed):
+			s.arcs[from] = append(s.arcs[from], to)
+		}
+
+		if group.Direction != "" && group.Direction != BidirectionalDirection {
+			s.directed = true
+		}
+	}
+
+	s.components = nil
+	s.componentOf = nil
+	s.order = nil
+	s.cycle = nil
+	s.bridges = make([][2]int, 0)
+	s.articulationPoints = make([]int, 0)
+
+	return nil
+}
+
+func (s *GraphAnalysisSolver) Solve() {
+	neighbors := make([][]int, s.graph.n)
+	for node := range s.graph.n {
+		for _, edge := range s.graph.adjacencyList[node] {
+			neighbors[node] = append(neighbors[node], edge.node)
+		}
+	}
+	s.components, s.componentOf = tarjan(neighbors)
+
+	if s.directed {
+		s.findDirectedCycle()
+		if s.cycle == nil {
+			s.topologicalSort()
+		}
+	} else {
+		s.findUndirectedCycle()
+	}
+
+	s.findBridges()
+}
+
+// Finds the strongly connected components with Tarjan's algorithm, along with the component of every node;
+// components are completed in reverse topological order, so they are reversed at the end to follow the edges of the condensation
+func tarjan(neighbors [][]int) ([][]int, []int) {
+	n := len(neighbors)
+	components := make([][]int, 0)
+	componentOf := make([]int, n)
+	indices := make([]int, n)
+	lowLinks := make([]int, n)
+	onStack := make([]bool, n)
+	stack := make([]int, 0, n)
+	counter := 0
+
+	for i := range n {
+		indices[i] = -1
+	}
+
+	var visit func(node int)
+	visit = func(node int) {
+		indices[node] = counter
+		lowLinks[node] = counter
+		counter++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range neighbors[node] {
+			if indices[next] == -1 {
+				visit(next)
+				lowLinks[node] = min(lowLinks[node], lowLinks[next])
+			} else if onStack[next] {
+				lowLinks[node] = min(lowLinks[node], indices[next])
+			}
+		}
+
+		// the node is the root of a component, which is made up of every node above it on the stack
+		if lowLinks[node] == indices[node] {
+			component := make([]int, 0)
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == node {
+					break
+				}
+			}
+			slices.Sort(component)
+			components = append(components, component)
+		}
+	}
+
+	for node := range n {
+		if indices[node] == -1 {
+			visit(node)
+		}
+	}
+
+	slices.Reverse(components)
+	for i, component := range components {
+		for _, node := range component {
+			componentOf[node] = i
+		}
+	}
+
+	return components, componentOf
+}
+
+// Orders the nodes with Kahn's algorithm, always picking the smallest available node first;
+// the order is left empty if the graph contains a cycle
+func (s *GraphAnalysisSolver) topologicalSort() {
+	n := s.graph.n
+	inDegrees := make([]int, n)
+	for node := range n {
+		for _, next := range s.arcs[node] {
+			inDegrees[next]++
+		}
+	}
+
+	pq := make(utils.PriorityQueue[int, int], 0, n)
+	heap.Init(&pq)
+	for node := range n {
+		if inDegrees[node] == 0 {
+			heap.Push(&pq, &utils.Item[int, int]{Value: node, Priority: -node})
+		}
+	}
+
+	order := make([]int, 0, n)
+	for pq.Len() > 0 {
+		node := heap.Pop(&pq).(*utils.Item[int, int]).Value
+		order = append(order, node)
+
+		for _, next := range s.arcs[node] {
+			inDegrees[next]--
+			if inDegrees[next] == 0 {
+				heap.Push(&pq, &utils.Item[int, int]{Value: next, Priority: -next})
+			}
+		}
+	}
+
+	if len(order) == n {
+		s.order = order
+	}
+}
+
+// Finds a cycle going through an arc, which is either a self-loop or an arc between two nodes of the same
+// strongly connected component, closed by the shortest way back through any edges of that component;
+// the graph has no such cycle exactly when its arcs can be ordered topologically
+func (s *GraphAnalysisSolver) findDirectedCycle() {
+	for node := range s.graph.n {
+		if slices.Contains(s.arcs[node], node) {
+			s.cycle = []int{node, node}
+			return
+		}
+	}
+
+	for _, component := range s.components {
+		if len(component) < 2 {
+			continue
+		}
+
+		for _, start := range component {
+			index := slices.IndexFunc(s.arcs[start], func(next int) bool {
+				return s.componentOf[next] == s.componentOf[start]
+			})
+			if index == -1 {
+				continue
+			}
+
+			// searching for the shortest way back to the start, without leaving the component
+			first := s.arcs[start][index]
+			previous := map[int]int{first: -1}
+			queue := []int{first}
+			for len(queue) > 0 && queue[0] != start {
+				node := queue[0]
+				queue = queue[1:]
+
+				for _, edge := range s.graph.adjacencyList[node] {
+					if s.componentOf[edge.node] != s.componentOf[start] {
+						continue
+					}
+					if _, visited := previous[edge.node]; !visited {
+						previous[edge.node] = node
+						queue = append(queue, edge.node)
+					}
+				}
+			}
+
+			cycle := make([]int, 0)
+			for current := start; current != -1; current = previous[current] {
+				cycle = append(cycle, current)
+			}
+			cycle = append(cycle, start)
+			slices.Reverse(cycle)
+			s.cycle = cycle
+			return
+		}
+	}
+}
+
+// Finds a cycle in an undirected graph, closed by the first link whose ends are already connected
+// by the links before it
+func (s *GraphAnalysisSolver) findUndirectedCycle() {
+	forest := make(map[int][]int, s.graph.n)
+	components := utils.NewUnionFind(s.graph.n)
+
+	for _, link := range s.links {
+		from, to := link[0], link[1]
+		if components.Union(from, to) {
+			forest[from] = append(forest[from], to)
+			forest[to] = append(forest[to], from)
+			continue
+		}
+
+		// the cycle is the path between the two ends in the forest, closed by the link itself
+		previous := map[int]int{from: -1}
+		queue := []int{from}
+		for len(queue) > 0 && queue[0] != to {
+			node := queue[0]
+			queue = queue[1:]
+
+			for _, next := range forest[node] {
+				if _, visited := previous[next]; !visited {
+					previous[next] = node
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		cycle := make([]int, 0)
+		for current := to; current != -1; current = previous[current] {
+			cycle = append(cycle, current)
+		}
+		s.cycle = append(cycle, to)
+		return
+	}
+}
+
+// Finds the bridges and articulation points of the graph, ignoring the orientation of its edges;
+// links are identified by their index, so that parallel links are never mistaken for bridges
+func (s *GraphAnalysisSolver) findBridges() {
+	n := s.graph.n
+	neighbors := make([][][2]int, n)
+	for i, link := range s.links {
+		neighbors[link[0]] = append(neighbors[link[0]], [2]int{link[1], i})
+		neighbors[link[1]] = append(neighbors[link[1]], [2]int{link[0], i})
+	}
+
+	discovered := make([]int, n)
+	lowLinks := make([]int, n)
+	isArticulationPoint := make([]bool, n)
+	counter := 1
+
+	var visit func(node int, parentLink int)
+	visit = func(node int, parentLink int) {
+		discovered[node] = counter
+		lowLinks[node] = counter
+		counter++
+		children := 0
+
+		for _, neighbor := range neighbors[node] {
+			next, link := neighbor[0], neighbor[1]
+			if link == parentLink {
+				continue
+			}
+
+			if discovered[next] != 0 {
+				lowLinks[node] = min(lowLinks[node], discovered[next])
+				continue
+			}
+
+			children++
+			visit(next, link)
+			lowLinks[node] = min(lowLinks[node], lowLinks[next])
+
+			if lowLinks[next] > discovered[node] {
+				s.bridges = append(s.bridges, s.links[link])
+			}
+			if parentLink != -1 && lowLinks[next] >= discovered[node] {
+				isArticulationPoint[node] = true
+			}
+		}
+
+		// the root of the search is an articulation point only if it has more than one subtree
+		if parentLink == -1 && children > 1 {
+			isArticulationPoint[node] = true
+		}
+	}
+
+	for node := range n {
+		if discovered[node] == 0 {
+			visit(node, -1)
+		}
+	}
+
+	for node := range n {
+		if isArticulationPoint[node] {
+			s.articulationPoints = append(s.articulationPoints, node)
+		}
+	}
+}
+
+func (s *GraphAnalysisSolver) FormatResult() GraphAnalysisResult {
+	n := s.graph.n
+	result := GraphAnalysisResult{}
+
+	result.Message = "Graph is acyclic"
+	if s.cycle != nil {
+		result.Message = "Graph contains a cycle"
+	}
+	result.FormattedOutput = ""
+
+	// strongly connected components and the edges of the condensation between them
+	result.Components = make([][]NodeID, len(s.components))
+	for i, component := range s.components {
+		result.Components[i] = s.graph.labelPath(component)
+	}
+
+	result.Condensation = make([][2]int, 0)
+	seen := make(map[[2]int]bool)
+	for node := range n {
+		for _, edge := range s.graph.adjacencyList[node] {
+			link := [2]int{s.componentOf[node], s.componentOf[edge.node]}
+			if link[0] != link[1] && !seen[link] {
+				seen[link] = true
+				result.Condensation = append(result.Condensation, link)
+			}
+		}
+	}
+	slices.SortFunc(result.Condensation, func(a [2]int, b [2]int) int {
+		return slices.Compare(a[:], b[:])
+	})
+
+	if s.order != nil {
+		result.TopologicalOrder = s.graph.labelPath(s.order)
+	}
+	if s.cycle != nil {
+		result.Cycle = s.graph.labelPath(s.cycle)
+	}
+
+	result.Bridges = make([][2]NodeID, len(s.bridges))
+	for i, bridge := range s.bridges {
+		result.Bridges[i] = [2]NodeID{s.graph.labels[bridge[0]], s.graph.labels[bridge[1]]}
+	}
+	result.ArticulationPoints = s.graph.labelPath(s.articulationPoints)
+
+	// statistics
+	weaklyConnected := utils.NewUnionFind(n)
+	degrees := make([]int, n)
+	selfLoops := 0
+	for _, link := range s.links {
+		weaklyConnected.Union(link[0], link[1])
+		degrees[link[0]]++
+		degrees[link[1]]++
+		if link[0] == link[1] {
+			selfLoops++
+		}
+	}
+
+	largest := 0
+	for _, component := range s.components {
+		largest = max(largest, len(component))
+	}
+
+	isolated := 0
+	for _, degree := range degrees {
+		if degree == 0 {
+			isolated++
+		}
+	}
+
+	density := 0.0
+	if n > 1 {
+		pairs := float64(n) * float64(n-1)
+		if !s.directed {
+			pairs /= 2
+		}
+		density = float64(len(s.links)) / pairs
+	}
+
+	result.Statistics = GraphAnalysisResultStatistics{
+		Nodes:                       n,
+		Edges:                       len(s.links),
+		Directed:                    s.directed,
+		StronglyConnectedComponents: len(s.components),
+		WeaklyConnectedComponents:   weaklyConnected.Count,
+		LargestComponentSize:        largest,
+		IsolatedNodes:               isolated,
+		SelfLoops:                   selfLoops,
+		Density:                     density,
+		Acyclic:                     s.cycle == nil,
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Strongly connected components: %d\n", len(result.Components))
+	for i, component := range result.Components {
+		result.FormattedOutput += fmt.Sprintf("Component %d: %v\n", i, component)
+	}
+	result.FormattedOutput += fmt.Sprintf("Condensation edges: %v\n", result.Condensation)
+	if result.TopologicalOrder != nil {
+		result.FormattedOutput += fmt.Sprintf("Topological order: %v\n", result.TopologicalOrder)
+	}
+	if result.Cycle != nil {
+		result.FormattedOutput += fmt.Sprintf("Cycle: %v\n", result.Cycle)
+	}
+	result.FormattedOutput += fmt.Sprintf("Bridges: %v\n", result.Bridges)
+	result.FormattedOutput += fmt.Sprintf("Articulation points: %v\n", result.ArticulationPoints)
+	result.FormattedOutput += fmt.Sprintf("Weakly connected components: %d\n", weaklyConnected.Count)
+	result.FormattedOutput += fmt.Sprintf("Density: %.4f\n", density)
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type GraphAnalysisResult struct {
+	Message            string                        `json:"message"`
+	Components         [][]NodeID                    `json:"components"`
+	Condensation       [][2]int                      `json:"condensation"`
+	TopologicalOrder   []NodeID                      `json:"topological_order,omitempty"`
+	Cycle              []NodeID                      `json:"cycle,omitempty"`
+	Bridges            [][2]NodeID                   `json:"bridges"`
+	ArticulationPoints []NodeID                      `json:"articulation_points"`
+	Statistics         GraphAnalysisResultStatistics `json:"statistics"`
+	FormattedOutput    string                        `json:"formatted_output"`
+}
+
+type GraphAnalysisResultStatistics struct {
+	Nodes                       int     `json:"nodes"`
+	Edges                       int     `json:"edges"`
+	Directed                    bool    `json:"directed"`
+	StronglyConnectedComponents int     `json:"strongly_connected_components"`
+	WeaklyConnectedComponents   int     `json:"weakly_connected_components"`
+	LargestComponentSize        int     `json:"largest_component_size"`
+	IsolatedNodes               int     `json:"isolated_nodes"`
+	SelfLoops                   int     `json:"self_loops"`
+	Density                     float64 `json:"density"`
+	Acyclic                     bool    `json:"acyclic"`
+}
@@ -0,0 +1,147 @@
+package solvers
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestGraphAnalysis(t *testing.T) {
+	type Test struct {
+		n                          int
+		edges                      [][3]int
+		directed                   bool
+		expectedComponents         [][]int
+		expectedOrder              []int
+		expectedCycle              []int
+		expectedBridges            [][2]int
+		expectedArticulationPoints []int
+		expectedWeakComponents     int
+	}
+
+	tests := []Test{
+		{
+			n:                          6,
+			edges:                      [][3]int{{5, 2, 1}, {5, 0, 1}, {4, 0, 1}, {4, 1, 1}, {2, 3, 1}, {3, 1, 1}},
+			directed:                   true,
+			expectedComponents:         [][]int{{5}, {4}, {2}, {3}, {1}, {0}},
+			expectedOrder:              []int{4, 5, 0, 2, 3, 1},
+			expectedCycle:              nil,
+			expectedBridges:            [][2]int{},
+			expectedArticulationPoints: []int{},
+			expectedWeakComponents:     1,
+		},
+		{
+			n:                          5,
+			edges:                      [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {2, 3, 1}, {3, 4, 1}, {4, 3, 1}},
+			directed:                   true,
+			expectedComponents:         [][]int{{0, 1, 2}, {3, 4}},
+			expectedOrder:              nil,
+			expectedCycle:              []int{0, 1, 2, 0},
+			expectedBridges:            [][2]int{{2, 3}},
+			expectedArticulationPoints: []int{2, 3},
+			expectedWeakComponents:     1,
+		},
+		{
+			n:                          6,
+			edges:                      [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}, {1, 3, 1}, {4, 5, 1}},
+			directed:                   false,
+			expectedComponents:         [][]int{{4, 5}, {0, 1, 2, 3}},
+			expectedOrder:              nil,
+			expectedCycle:              []int{0, 1, 2, 0},
+			expectedBridges:            [][2]int{{1, 3}, {4, 5}},
+			expectedArticulationPoints: []int{1},
+			expectedWeakComponents:     2,
+		},
+		{
+			n:                          2,
+			edges:                      [][3]int{{0, 1, 1}, {0, 1, 1}, {1, 1, 1}},
+			directed:                   true,
+			expectedComponents:         [][]int{{0}, {1}},
+			expectedOrder:              nil,
+			expectedCycle:              []int{1, 1},
+			expectedBridges:            [][2]int{},
+			expectedArticulationPoints: []int{},
+			expectedWeakComponents:     1,
+		},
+	}
+
+	for testCount, test := range tests {
+		solver := GraphAnalysisSolver{}
+		err := solver.Initialize(test.n, toGraphEdges(test.edges), test.directed)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if !slices.EqualFunc(solver.components, test.expectedComponents, slices.Equal) {
+			t.Errorf("[Test %d] The components do not match the ones expected.\nActual: %v\nExpected: %v", testCount+1, solver.components, test.expectedComponents)
+		}
+		if !validateArray(solver.order, test.expectedOrder) {
+			t.Errorf("[Test %d] The topological order does not match the one expected.\nActual: %v\nExpected: %v", testCount+1, solver.order, test.expectedOrder)
+		}
+		if (test.expectedCycle == nil) != (solver.cycle == nil) || (test.expectedCycle != nil && !validateCycle(solver.cycle, test.expectedCycle)) {
+			t.Errorf("[Test %d] The cycle does not match the one expected.\nActual: %v\nExpected: %v", testCount+1, solver.cycle, test.expectedCycle)
+		}
+		if !validateArray(solver.bridges, test.expectedBridges) {
+			t.Errorf("[Test %d] The bridges do not match the ones expected.\nActual: %v\nExpected: %v", testCount+1, solver.bridges, test.expectedBridges)
+		}
+		if !validateArray(solver.articulationPoints, test.expectedArticulationPoints) {
+			t.Errorf("[Test %d] The articulation points do not match the ones expected.\nActual: %v\nExpected: %v", testCount+1, solver.articulationPoints, test.expectedArticulationPoints)
+		}
+		if result.Statistics.WeaklyConnectedComponents != test.expectedWeakComponents {
+			t.Errorf("[Test %d] The number of weakly connected components does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, result.Statistics.WeaklyConnectedComponents, test.expectedWeakComponents)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that the undirected edges of a mixed graph are not mistaken for cycles of two nodes
+	edges := toGraphEdges([][3]int{{0, 1, 1}, {1, 2, 1}})
+	edges[1].Direction = ForwardDirection
+	solver := GraphAnalysisSolver{}
+	err := solver.Initialize(3, edges, false)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	solver.Solve()
+	if solver.cycle != nil || !validateArray(solver.order, []int{0, 1, 2}) {
+		t.Errorf("Expected the mixed graph to be acyclic with the order [0 1 2], got the cycle %v and the order %v.", solver.cycle, solver.order)
+	}
+
+	// validating that the directed edges of a mixed graph can still form a cycle
+	edges = append(edges, GraphEdge{From: indexNode(2), To: indexNode(1), Weight: 1, Direction: ForwardDirection})
+	err = solver.Initialize(3, edges, false)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	solver.Solve()
+	if !validateCycle(solver.cycle, []int{1, 2, 1}) {
+		t.Errorf("Expected the cycle [1 2 1], got %v.", solver.cycle)
+	}
+
+	// validating that a cycle can also go through the undirected edges of a mixed graph, in line with its components
+	edges = toGraphEdges([][3]int{{0, 1, 1}, {1, 2, 1}, {2, 0, 1}})
+	edges[0].Direction = ForwardDirection
+	edges[1].Direction = BidirectionalDirection
+	edges[2].Direction = ForwardDirection
+	err = solver.Initialize(3, edges, false)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	solver.Solve()
+	if len(solver.components) != 1 || !validateCycle(solver.cycle, []int{0, 1, 2, 0}) || solver.order != nil {
+		t.Errorf("Expected a single component with the cycle [0 1 2 0] and no order, got the components %v, the cycle %v and the order %v.", solver.components, solver.cycle, solver.order)
+	}
+}
@@ -0,0 +1,330 @@
+package solvers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Algorithms that can be selected when solving a Graph Coloring problem instance
+const (
+	ForwardCheckingAlgorithm = "forward-checking"
+	DSaturAlgorithm          = "dsatur"
+)
+
+// Limits the number of iterations of every forward checking search, since coloring is NP-hard
+const maxColoringIterations = 100000
+
+// Represents a partial coloring of a graph, where every node is a variable whose domain holds the colors
+// that none of its colored neighbors use; the adjacency lists are shared between copies
+type coloring struct {
+	colors    []int
+	domains   []cspDomain
+	neighbors [][]int
+}
+
+func newColoring(neighbors [][]int, k int) *coloring {
+	c := coloring{
+		colors:    make([]int, len(neighbors)),
+		domains:   make([]cspDomain, len(neighbors)),
+		neighbors: neighbors,
+	}
+
+	for node := range neighbors {
+		c.colors[node] = -1
+		c.domains[node] = make(cspDomain, k)
+		for color := range k {
+			c.domains[node][color] = true
+		}
+	}
+
+	return &c
+}
+
+func (c *coloring) variableCount() int {
+	return len(c.colors)
+}
+
+func (c *coloring) isAssigned(node int) bool {
+	return c.colors[node] != -1
+}
+
+func (c *coloring) domain(node int) cspDomain {
+	return c.domains[node]
+}
+
+func (c *coloring) assign(node int, color int) {
+	c.colors[node] = color
+	for _, neighbor := range c.neighbors[node] {
+		if c.colors[neighbor] == -1 {
+			delete(c.domains[neighbor], color)
+		}
+	}
+}
+
+func (c *coloring) cloneDeep() *coloring {
+	copy := coloring{
+		colors:    slices.Clone(c.colors),
+		domains:   make([]cspDomain, len(c.domains)),
+		neighbors: c.neighbors,
+	}
+
+	for node, domain := range c.domains {
+		copy.domains[node] = maps.Clone(domain)
+	}
+
+	return &copy
+}
+
+// Handles the problem solving logic
+type GraphColoringSolver struct {
+	graph           graph
+	neighbors       [][]int
+	k               int
+	algorithm       string
+	colors          []int
+	colorCount      int
+	chromaticNumber int
+	iterations      int
+	exhausted       bool
+}
+
+// Prepares a coloring of the given graph with at most k colors, or with as few colors as possible if k is not positive;
+// edges are treated as undirected
+func (s *GraphColoringSolver) Initialize(n int, edges []GraphEdge, k int, algorithm string) error {
+	s.algorithm = algorithm
+	if s.algorithm == "" {
+		s.algorithm = ForwardCheckingAlgorithm
+	}
+	if s.algorithm != ForwardCheckingAlgorithm && s.algorithm != DSaturAlgorithm {
+		return fmt.Errorf("Unknown algorithm \"%s\". Supported algorithms are \"%s\" and \"%s\".", s.algorithm, ForwardCheckingAlgorithm, DSaturAlgorithm)
+	}
+
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{allowNegative: true})
+	if err != nil {
+		return err
+	}
+
+	s.neighbors = make([][]int, s.graph.n)
+	seen := make(map[[2]int]bool)
+	for _, group := range edges {
+		from, _ := s.graph.resolve(group.From)
+		to, _ := s.graph.resolve(group.To)
+		if from == to {
+			return fmt.Errorf("Edge %s is a self-loop, so node %s can not be colored.", group.String(), group.From)
+		}
+
+		pair := [2]int{min(from, to), max(from, to)}
+		if !seen[pair] {
+			seen[pair] = true
+			s.neighbors[from] = append(s.neighbors[from], to)
+			s.neighbors[to] = append(s.neighbors[to], from)
+		}
+	}
+
+	s.k = max(0, k)
+	s.colors = nil
+	s.colorCount = 0
+	s.chromaticNumber = 0
+	s.iterations = 0
+	s.exhausted = false
+
+	return nil
+}
+
+func (s *GraphColoringSolver) Solve() {
+	if s.k > 0 {
+		if s.algorithm == DSaturAlgorithm {
+			s.dsatur()
+			if s.colorCount > s.k {
+				s.colors = nil
+			}
+		} else {
+			s.forwardChecking(s.k)
+		}
+		return
+	}
+
+	// DSatur gives a first coloring, which forward checking then tries to improve one color at a time,
+	// until it proves that no coloring with fewer colors exists (or the largest clique found is reached)
+	s.dsatur()
+	lowerBound := s.clique()
+	if s.algorithm == DSaturAlgorithm {
+		if s.colorCount == lowerBound {
+			s.chromaticNumber = s.colorCount
+		}
+		return
+	}
+
+	colors, colorCount := s.colors, s.colorCount
+	for colorCount > lowerBound {
+		s.forwardChecking(colorCount - 1)
+		if s.colors == nil {
+			break
+		}
+		colors, colorCount = s.colors, s.colorCount
+	}
+
+	if !s.exhausted {
+		s.chromaticNumber = colorCount
+	}
+	s.colors, s.colorCount = colors, colorCount
+}
+
+// Searches for a coloring with at most k colors, using the forward checking engine
+func (s *GraphColoringSolver) forwardChecking(k int) {
+	search := newForwardChecking(newColoring(s.neighbors, k), maxColoringIterations)
+	search.run()
+
+	s.iterations += search.iterations
+	s.exhausted = s.exhausted || search.exhausted
+	s.colors = nil
+	s.colorCount = 0
+	if search.solvable {
+		s.colors = search.current.colors
+		s.colorCount = countColors(s.colors)
+	}
+}
+
+// Colors the nodes greedily with the DSatur algorithm, always coloring the node whose neighbors already use
+// the most distinct colors (breaking ties by the highest degree) with the smallest color none of them use
+func (s *GraphColoringSolver) dsatur() {
+	n := s.graph.n
+	s.colors = make([]int, n)
+	saturation := make([]map[int]bool, n)
+	for node := range n {
+		s.colors[node] = -1
+		saturation[node] = make(map[int]bool)
+	}
+
+	for range n {
+		selected := -1
+		for node := range n {
+			if s.colors[node] != -1 {
+				continue
+			}
+
+			if selected == -1 || len(saturation[node]) > len(saturation[selected]) ||
+				(len(saturation[node]) == len(saturation[selected]) && len(s.neighbors[node]) > len(s.neighbors[selected])) {
+				selected = node
+			}
+		}
+
+		color := 0
+		for saturation[selected][color] {
+			color++
+		}
+
+		s.colors[selected] = color
+		for _, neighbor := range s.neighbors[selected] {
+			saturation[neighbor][color] = true
+		}
+		s.iterations++
+	}
+
+	s.colorCount = countColors(s.colors)
+}
+
+// Finds a clique greedily, starting from every node and extending it with the neighbors of the highest degree;
+// its size is a lower bound for the number of colors
+func (s *GraphColoringSolver) clique() int {
+	n := s.graph.n
+	adjacent := make([]map[int]bool, n)
+	for node := range n {
+		adjacent[node] = make(map[int]bool, len(s.neighbors[node]))
+		for _, neighbor := range s.neighbors[node] {
+			adjacent[node][neighbor] = true
+		}
+	}
+
+	best := min(1, n)
+	for start := range n {
+		candidates := slices.Clone(s.neighbors[start])
+		slices.SortStableFunc(candidates, func(a int, b int) int {
+			return len(s.neighbors[b]) - len(s.neighbors[a])
+		})
+
+		clique := []int{start}
+		for _, candidate := range candidates {
+			connected := true
+			for _, member := range clique {
+				if !adjacent[member][candidate] {
+					connected = false
+					break
+				}
+			}
+			if connected {
+				clique = append(clique, candidate)
+			}
+		}
+
+		best = max(best, len(clique))
+	}
+
+	return best
+}
+
+func countColors(colors []int) int {
+	count := 0
+	for _, color := range colors {
+		count = max(count, color+1)
+	}
+
+	return count
+}
+
+func (s *GraphColoringSolver) FormatResult() GraphColoringResult {
+	result := GraphColoringResult{}
+
+	result.Algorithm = s.algorithm
+	result.Iterations = s.iterations
+	result.ChromaticNumber = s.chromaticNumber
+	result.Solution = make([]GraphColoringResultNode, 0)
+	result.FormattedOutput = ""
+
+	if s.colors == nil {
+		result.Message = fmt.Sprintf("No coloring with %d colors", s.k)
+		if s.exhausted {
+			result.Message = fmt.Sprintf("No coloring with %d colors found within %d iterations", s.k, maxColoringIterations)
+		} else if s.algorithm == DSaturAlgorithm {
+			result.Message = fmt.Sprintf("DSatur could not find a coloring with %d colors", s.k)
+		}
+
+		return result
+	}
+
+	result.Message = "Solution found"
+	if s.k == 0 && s.chromaticNumber == 0 {
+		result.Message = "Solution found, but it is not proven to use the fewest colors"
+	}
+	result.Colors = s.colorCount
+
+	for node, color := range s.colors {
+		result.Solution = append(result.Solution, GraphColoringResultNode{Node: s.graph.labels[node], Color: color})
+		result.FormattedOutput += fmt.Sprintf("Node %s: color %d\n", s.graph.labels[node], color)
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Colors used: %d\n", s.colorCount)
+	if s.chromaticNumber > 0 {
+		result.FormattedOutput += fmt.Sprintf("Chromatic number: %d\n", s.chromaticNumber)
+	}
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type GraphColoringResult struct {
+	Message         string                    `json:"message"`
+	Algorithm       string                    `json:"algorithm"`
+	Colors          int                       `json:"colors"`
+	ChromaticNumber int                       `json:"chromatic_number,omitempty"`
+	Iterations      int                       `json:"iterations"`
+	Solution        []GraphColoringResultNode `json:"solution"`
+	FormattedOutput string                    `json:"formatted_output"`
+}
+
+type GraphColoringResultNode struct {
+	Node  NodeID `json:"node"`
+	Color int    `json:"color"`
+}
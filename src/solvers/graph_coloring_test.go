@@ -0,0 +1,71 @@
+package solvers
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGraphColoring(t *testing.T) {
+	type Test struct {
+		n                 int
+		edges             [][3]int
+		k                 int
+		algorithm         string
+		expectedSolvable  bool
+		expectedChromatic int
+	}
+
+	// the Petersen graph: an outer 5-cycle, an inner pentagram and the spokes between them
+	petersen := [][3]int{
+		{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 4, 1}, {4, 0, 1},
+		{5, 7, 1}, {7, 9, 1}, {9, 6, 1}, {6, 8, 1}, {8, 5, 1},
+		{0, 5, 1}, {1, 6, 1}, {2, 7, 1}, {3, 8, 1}, {4, 9, 1},
+	}
+	oddCycle := [][3]int{{0, 1, 1}, {1, 2, 1}, {2, 3, 1}, {3, 4, 1}, {4, 0, 1}}
+	wheel := append([][3]int{{5, 0, 1}, {5, 1, 1}, {5, 2, 1}, {5, 3, 1}, {5, 4, 1}}, oddCycle...)
+
+	tests := []Test{
+		{n: 10, edges: petersen, k: 3, algorithm: ForwardCheckingAlgorithm, expectedSolvable: true},
+		{n: 10, edges: petersen, k: 2, algorithm: ForwardCheckingAlgorithm, expectedSolvable: false},
+		{n: 10, edges: petersen, k: 0, algorithm: ForwardCheckingAlgorithm, expectedSolvable: true, expectedChromatic: 3},
+		{n: 6, edges: wheel, k: 0, algorithm: ForwardCheckingAlgorithm, expectedSolvable: true, expectedChromatic: 4},
+		{n: 6, edges: wheel, k: 4, algorithm: DSaturAlgorithm, expectedSolvable: true},
+		{n: 4, edges: [][3]int{{0, 1, 1}, {2, 3, 1}}, k: 0, algorithm: DSaturAlgorithm, expectedSolvable: true, expectedChromatic: 2},
+	}
+
+	for testCount, test := range tests {
+		solver := GraphColoringSolver{}
+		err := solver.Initialize(test.n, toGraphEdges(test.edges), test.k, test.algorithm)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if (solver.colors != nil) != test.expectedSolvable {
+			t.Errorf("[%s] The solvability does not match the one expected.\nActual: %v\nExpected: %v", test.algorithm, solver.colors != nil, test.expectedSolvable)
+		}
+		if result.ChromaticNumber != test.expectedChromatic {
+			t.Errorf("[%s] The chromatic number does not match the one expected.\nActual: %d\nExpected: %d", test.algorithm, result.ChromaticNumber, test.expectedChromatic)
+		}
+		if test.k > 0 && result.Colors > test.k {
+			t.Errorf("[%s] The coloring uses more colors than allowed.\nActual: %d\nExpected: at most %d", test.algorithm, result.Colors, test.k)
+		}
+
+		// validating that no edge connects two nodes of the same color
+		for _, edge := range test.edges {
+			if solver.colors != nil && solver.colors[edge[0]] == solver.colors[edge[1]] {
+				t.Errorf("[%s] Nodes %d and %d are connected, but they have the same color.", test.algorithm, edge[0], edge[1])
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d (%s) -------------------\n", testCount+1, test.algorithm)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
@@ -9,10 +9,13 @@ import (
 	"github.com/vanessahoamea/algorithms-api/src/utils"
 )
 
-// Represents an item that can be added to the knapsack
+// Represents an item that can be added to the knapsack, along with the number of copies available
+// (which is unbounded for unlimited items)
 type item struct {
-	value  int
-	weight int
+	value     int
+	weight    int
+	quantity  int
+	unlimited bool
 }
 
 func (i *item) compareRatio(other *item) bool {
@@ -28,10 +31,16 @@ type knapsack struct {
 	items    []item
 }
 
-func (k *knapsack) initialize(values []int, weights []int, capacity int) error {
+func (k *knapsack) initialize(values []int, weights []int, capacity int, options KnapsackOptions) error {
 	if len(values) != len(weights) {
 		return fmt.Errorf("Lenght of values array (%d) does not match length of weights arrays (%d).", len(values), len(weights))
 	}
+	if options.Quantities != nil && len(options.Quantities) != len(values) {
+		return fmt.Errorf("Length of quantities array (%d) does not match length of values array (%d).", len(options.Quantities), len(values))
+	}
+	if options.Unlimited != nil && len(options.Unlimited) != len(values) {
+		return fmt.Errorf("Length of unlimited array (%d) does not match length of values array (%d).", len(options.Unlimited), len(values))
+	}
 
 	if slices.Contains(weights, 0) {
 		return errors.New("Weights array can not contain null values.")
@@ -41,16 +50,87 @@ func (k *knapsack) initialize(values []int, weights []int, capacity int) error {
 	k.n = len(values)
 	k.items = make([]item, k.n)
 	for i := range values {
-		k.items[i] = item{value: values[i], weight: weights[i]}
+		k.items[i] = item{value: values[i], weight: weights[i], quantity: 1}
+
+		quantity := options.quantity(i)
+		if quantity != nil {
+			k.items[i].quantity = *quantity
+		}
+		if k.items[i].quantity < 0 {
+			return fmt.Errorf("Quantity of item %d can not be negative, got %d.", i, k.items[i].quantity)
+		}
+
+		if options.Unlimited != nil && options.Unlimited[i] {
+			if quantity != nil {
+				return fmt.Errorf("Item %d can either have a quantity or be unlimited, but not both.", i)
+			}
+			if weights[i] < 0 {
+				return fmt.Errorf("Unlimited item %d must have a positive weight, got %d.", i, weights[i])
+			}
+			k.items[i].unlimited = true
+		}
 	}
 
 	return nil
 }
 
+// Returns the number of copies of the item that can be selected, which is never more than the number of copies
+// that fit in the knapsack (the only bound of unlimited items), so that the bundles can not overflow
+func (k *knapsack) availableCopies(i int) int {
+	if k.items[i].unlimited {
+		return max(k.capacity/k.items[i].weight, 0)
+	}
+	if k.items[i].weight > 0 {
+		return min(k.items[i].quantity, max(k.capacity/k.items[i].weight, 0))
+	}
+
+	return k.items[i].quantity
+}
+
+// Represents a group of copies of the same item, which are selected together
+type itemBundle struct {
+	index  int
+	copies int
+}
+
+// Splits the copies of every item into bundles of 1, 2, 4, ... copies, followed by the remaining ones,
+// so that any number of copies up to the available one is the sum of some of the bundles; the items
+// can then be selected with the 0/1 version of the algorithm, using a logarithmic number of bundles for each
+func (k *knapsack) splitItems() []itemBundle {
+	bundles := make([]itemBundle, 0, k.n)
+	for i := range k.n {
+		remaining := k.availableCopies(i)
+		for size := 1; remaining > 0; size *= 2 {
+			copies := min(size, remaining)
+			bundles = append(bundles, itemBundle{index: i, copies: copies})
+			remaining -= copies
+		}
+	}
+
+	return bundles
+}
+
+// Represents the optional settings of the Knapsack problem, given for every item
+type KnapsackOptions struct {
+	// number of copies of every item, where a missing quantity stands for a single copy
+	Quantities []*int
+	// whether every item has an unbounded number of copies
+	Unlimited []bool
+}
+
+// Returns the quantity given for the item, or nil if there is none
+func (o KnapsackOptions) quantity(i int) *int {
+	if o.Quantities == nil {
+		return nil
+	}
+
+	return o.Quantities[i]
+}
+
 // Handles the problem solving logic
 type KnapsackSolver struct {
 	knapsack         knapsack
-	binaryItems      map[int]bool
+	binaryItems      map[int]int
 	binaryValue      int
 	binaryWeight     int
 	fractionalItems  map[int]float64
@@ -58,18 +138,18 @@ type KnapsackSolver struct {
 	fractionalWeight float64
 }
 
-func (s *KnapsackSolver) Initialize(values []int, weights []int, capacity int) error {
+func (s *KnapsackSolver) Initialize(values []int, weights []int, capacity int, options KnapsackOptions) error {
 	s.knapsack = knapsack{}
-	err := s.knapsack.initialize(values, weights, capacity)
+	err := s.knapsack.initialize(values, weights, capacity, options)
 	if err != nil {
 		return err
 	}
 
 	n := s.knapsack.n
 
-	s.binaryItems = make(map[int]bool, n)
+	s.binaryItems = make(map[int]int, n)
 	for i := range n {
-		s.binaryItems[i] = false
+		s.binaryItems[i] = 0
 	}
 	s.binaryValue = 0
 	s.binaryWeight = 0
@@ -90,9 +170,18 @@ func (s *KnapsackSolver) Solve() {
 }
 
 func (s *KnapsackSolver) solveBinaryVersion() {
-	n := s.knapsack.n
 	capacity := s.knapsack.capacity
-	items := s.knapsack.items
+	bundles := s.knapsack.splitItems()
+	n := len(bundles)
+
+	// every bundle is treated as a single item, holding all of its copies
+	items := make([]item, n)
+	for i, bundle := range bundles {
+		items[i] = item{
+			value:  s.knapsack.items[bundle.index].value * bundle.copies,
+			weight: s.knapsack.items[bundle.index].weight * bundle.copies,
+		}
+	}
 
 	table := make([][]int, n+1)
 	for i := range n + 1 {
@@ -117,7 +206,7 @@ func (s *KnapsackSolver) solveBinaryVersion() {
 	currentWeight := capacity
 	for i := n; i > 0 && currentValue > 0; i-- {
 		if currentValue != table[i-1][currentWeight] {
-			s.binaryItems[i-1] = true
+			s.binaryItems[bundles[i-1].index] += bundles[i-1].copies
 			s.binaryWeight += items[i-1].weight
 			currentValue -= items[i-1].value
 			currentWeight -= items[i-1].weight
@@ -131,13 +220,20 @@ func (s *KnapsackSolver) solveFractionalVersion() {
 	n := s.knapsack.n
 	capacity := s.knapsack.capacity
 
-	// creating a copy of the items array
+	// creating a copy of the items array, where the copies of every item are gathered into a single item
+	// that can be split into fractions, holding as many copies of the unlimited items as fit in the knapsack
 	items := make([]item, len(s.knapsack.items))
+	stock := make([]float64, n)
 	for i := range n {
 		items[i] = item{
 			value:  s.knapsack.items[i].value,
 			weight: s.knapsack.items[i].weight,
 		}
+
+		stock[i] = float64(s.knapsack.items[i].quantity)
+		if s.knapsack.items[i].unlimited {
+			stock[i] = max(float64(capacity)/float64(items[i].weight), 0)
+		}
 	}
 
 	// preserving the original order of the items before sorting, so we can build the solution
@@ -154,17 +250,23 @@ func (s *KnapsackSolver) solveFractionalVersion() {
 		return items[i].compareRatio(&items[j])
 	})
 
+	remaining := float64(capacity)
 	for newIndex, oldIndex := range indices {
-		if items[newIndex].weight <= capacity {
-			s.fractionalItems[oldIndex] = 1.0
-			s.fractionalValue += float64(items[newIndex].value)
-			s.fractionalWeight += float64(items[newIndex].weight)
-			capacity -= items[newIndex].weight
+		if stock[oldIndex] == 0 {
+			continue
+		}
+
+		weight := float64(items[newIndex].weight) * stock[oldIndex]
+		if weight <= remaining {
+			s.fractionalItems[oldIndex] = stock[oldIndex]
+			s.fractionalValue += float64(items[newIndex].value) * stock[oldIndex]
+			s.fractionalWeight += weight
+			remaining -= weight
 		} else {
-			ratio := float64(capacity) / float64(items[newIndex].weight)
-			s.fractionalItems[oldIndex] = ratio
-			s.fractionalValue += float64(items[newIndex].value) * ratio
-			s.fractionalWeight += float64(capacity)
+			copies := remaining / float64(items[newIndex].weight)
+			s.fractionalItems[oldIndex] = copies
+			s.fractionalValue += float64(items[newIndex].value) * copies
+			s.fractionalWeight += remaining
 			break
 		}
 	}
@@ -181,21 +283,26 @@ func (s *KnapsackSolver) FormatResult() KnapsackResult {
 	result.BinarySolution.MaxValue = s.binaryValue
 	result.BinarySolution.MaxWeight = s.binaryWeight
 	result.BinarySolution.SelectedItems = make([]KnapsackResultItem[int], 0, s.knapsack.n)
-	for i := range s.binaryItems {
-		if s.binaryItems[i] {
+	for i := range s.knapsack.n {
+		if s.binaryItems[i] > 0 {
 			if len(result.BinarySolution.SelectedItems) == 0 {
 				result.FormattedOutput += "Binary version:\n"
 			}
 
 			item := KnapsackResultItem[int]{
 				Number: i,
-				Value:  s.knapsack.items[i].value,
-				Weight: s.knapsack.items[i].weight,
+				Value:  s.knapsack.items[i].value * s.binaryItems[i],
+				Weight: s.knapsack.items[i].weight * s.binaryItems[i],
 				Ratio:  1.0,
+				Copies: s.binaryItems[i],
 			}
 			result.BinarySolution.SelectedItems = append(result.BinarySolution.SelectedItems, item)
 
-			result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%d, Weight = %d kg\n", item.Number, item.Value, item.Weight)
+			if item.Copies == 1 {
+				result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%d, Weight = %d kg\n", item.Number, item.Value, item.Weight)
+			} else {
+				result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%d, Weight = %d kg (%d copies)\n", item.Number, item.Value, item.Weight, item.Copies)
+			}
 		}
 	}
 
@@ -209,7 +316,7 @@ func (s *KnapsackSolver) FormatResult() KnapsackResult {
 	result.FractionalSolution.MaxValue = s.fractionalValue
 	result.FractionalSolution.MaxWeight = s.fractionalWeight
 	result.FractionalSolution.SelectedItems = make([]KnapsackResultItem[float64], 0, s.knapsack.n)
-	for i := range s.fractionalItems {
+	for i := range s.knapsack.n {
 		if s.fractionalItems[i] > 0.0 {
 			if len(result.FractionalSolution.SelectedItems) == 0 {
 				if hasBinarySolution {
@@ -218,18 +325,29 @@ func (s *KnapsackSolver) FormatResult() KnapsackResult {
 				result.FormattedOutput += "Fractional version:\n"
 			}
 
+			// the ratio is the fraction of the available copies that is taken, which is the fraction of the whole object
+			// for items with a single copy
+			copies := s.fractionalItems[i]
+			ratio := copies / float64(s.knapsack.items[i].quantity)
+			if s.knapsack.items[i].unlimited {
+				ratio = copies / (float64(s.knapsack.capacity) / float64(s.knapsack.items[i].weight))
+			}
+
 			item := KnapsackResultItem[float64]{
 				Number: i,
-				Value:  float64(s.knapsack.items[i].value) * s.fractionalItems[i],
-				Weight: float64(s.knapsack.items[i].weight) * s.fractionalItems[i],
-				Ratio:  s.fractionalItems[i],
+				Value:  float64(s.knapsack.items[i].value) * copies,
+				Weight: float64(s.knapsack.items[i].weight) * copies,
+				Ratio:  ratio,
+				Copies: copies,
 			}
 			result.FractionalSolution.SelectedItems = append(result.FractionalSolution.SelectedItems, item)
 
-			if utils.FloatEqual(item.Ratio, 1.0) {
+			if utils.FloatEqual(item.Copies, 1.0) {
 				result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%.2f, Weight = %.2f kg\n", item.Number, item.Value, item.Weight)
+			} else if item.Copies < 1.0 {
+				result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%.2f, Weight = %.2f kg (%.2f%% of whole object)\n", item.Number, item.Value, item.Weight, item.Copies*100.0)
 			} else {
-				result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%.2f, Weight = %.2f kg (%.2f%% of whole object)\n", item.Number, item.Value, item.Weight, item.Ratio*100.0)
+				result.FormattedOutput += fmt.Sprintf("Item %d: Value = $%.2f, Weight = %.2f kg (%.2f copies)\n", item.Number, item.Value, item.Weight, item.Copies)
 			}
 		}
 	}
@@ -272,4 +390,5 @@ type KnapsackResultItem[T int | float64] struct {
 	Value  T       `json:"value"`
 	Weight T       `json:"weight"`
 	Ratio  float64 `json:"ratio"`
+	Copies T       `json:"copies"`
 }
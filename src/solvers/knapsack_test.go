@@ -2,6 +2,8 @@ package solvers
 
 import (
 	"fmt"
+	"maps"
+	"math"
 	"testing"
 
 	"github.com/vanessahoamea/algorithms-api/src/utils"
@@ -47,7 +49,7 @@ func TestKnapsack(t *testing.T) {
 
 	for testCount, test := range testCases {
 		solver := KnapsackSolver{}
-		err := solver.Initialize(test.values, test.weights, test.capacity)
+		err := solver.Initialize(test.values, test.weights, test.capacity, KnapsackOptions{})
 
 		// validating input data
 		if err != nil {
@@ -93,6 +95,116 @@ func TestKnapsack(t *testing.T) {
 	}
 }
 
+func TestKnapsackCopies(t *testing.T) {
+	type testCase struct {
+		values                   []int
+		weights                  []int
+		capacity                 int
+		options                  KnapsackOptions
+		expectedBinaryCopies     map[int]int
+		expectedBinaryValue      int
+		expectedFractionalCopies map[int]float64
+		expectedFractionalValue  float64
+	}
+
+	testCases := []testCase{
+		{
+			values:                   []int{10, 40, 30, 50},
+			weights:                  []int{5, 4, 6, 3},
+			capacity:                 10,
+			options:                  KnapsackOptions{Unlimited: []bool{true, true, true, true}},
+			expectedBinaryCopies:     map[int]int{3: 3},
+			expectedBinaryValue:      150,
+			expectedFractionalCopies: map[int]float64{3: 3.33},
+			expectedFractionalValue:  166.67,
+		},
+		{
+			values:                   []int{60, 100, 120},
+			weights:                  []int{10, 20, 30},
+			capacity:                 50,
+			options:                  KnapsackOptions{Quantities: []*int{quantityOf(2), nil, nil}},
+			expectedBinaryCopies:     map[int]int{0: 2, 2: 1},
+			expectedBinaryValue:      240,
+			expectedFractionalCopies: map[int]float64{0: 2, 1: 1, 2: 0.33},
+			expectedFractionalValue:  260,
+		},
+		{
+			values:                   []int{3, 5},
+			weights:                  []int{2, 4},
+			capacity:                 51,
+			options:                  KnapsackOptions{Quantities: []*int{quantityOf(100), nil}, Unlimited: []bool{false, false}},
+			expectedBinaryCopies:     map[int]int{0: 25},
+			expectedBinaryValue:      75,
+			expectedFractionalCopies: map[int]float64{0: 25.5},
+			expectedFractionalValue:  76.5,
+		},
+		{
+			values:                   []int{3, 5},
+			weights:                  []int{2, 4},
+			capacity:                 9,
+			options:                  KnapsackOptions{Quantities: []*int{quantityOf(math.MaxInt), quantityOf(0)}},
+			expectedBinaryCopies:     map[int]int{0: 4},
+			expectedBinaryValue:      12,
+			expectedFractionalCopies: map[int]float64{0: 4.5},
+			expectedFractionalValue:  13.5,
+		},
+	}
+
+	for testCount, test := range testCases {
+		solver := KnapsackSolver{}
+		err := solver.Initialize(test.values, test.weights, test.capacity, test.options)
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		binaryCopies := make(map[int]int)
+		for _, item := range result.BinarySolution.SelectedItems {
+			binaryCopies[item.Number] = item.Copies
+		}
+		if !maps.Equal(binaryCopies, test.expectedBinaryCopies) {
+			t.Errorf("[Binary solution] The selected copies do not match the ones expected.\nActual: %v\nExpected: %v", binaryCopies, test.expectedBinaryCopies)
+		}
+		if result.BinarySolution.MaxValue != test.expectedBinaryValue {
+			t.Errorf("[Binary solution] The total value does not match the one expected.\nActual: %d\nExpected: %d", result.BinarySolution.MaxValue, test.expectedBinaryValue)
+		}
+
+		fractionalCopies := make(map[int]float64)
+		for _, item := range result.FractionalSolution.SelectedItems {
+			fractionalCopies[item.Number] = item.Copies
+		}
+		if !maps.EqualFunc(fractionalCopies, test.expectedFractionalCopies, utils.FloatEqual) {
+			t.Errorf("[Fractional solution] The selected copies do not match the ones expected.\nActual: %v\nExpected: %v", fractionalCopies, test.expectedFractionalCopies)
+		}
+		if !utils.FloatEqual(result.FractionalSolution.MaxValue, test.expectedFractionalValue) {
+			t.Errorf("[Fractional solution] The total value does not match the one expected.\nActual: %.2f\nExpected: %.2f", result.FractionalSolution.MaxValue, test.expectedFractionalValue)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that an item can not have a quantity and be unlimited at the same time
+	solver := KnapsackSolver{}
+	err := solver.Initialize([]int{1}, []int{1}, 5, KnapsackOptions{Quantities: []*int{quantityOf(2)}, Unlimited: []bool{true}})
+	if err == nil {
+		t.Errorf("Expected an item with a quantity that is also unlimited to be rejected.")
+	}
+
+	err = solver.Initialize([]int{1, 2}, []int{1, 2}, 5, KnapsackOptions{Quantities: []*int{quantityOf(2)}})
+	if err == nil {
+		t.Errorf("Expected a quantities array of a different length to be rejected.")
+	}
+}
+
+func quantityOf(quantity int) *int {
+	return &quantity
+}
+
 func validateSelectedItems[T int | float64](actualItems []KnapsackResultItem[T], expectedItems []int) bool {
 	itemsMap := make(map[int]bool, len(expectedItems))
 	for _, item := range expectedItems {
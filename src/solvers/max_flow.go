@@ -0,0 +1,355 @@
+package solvers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Algorithms that can be selected when solving a Maximum Flow problem instance
+const (
+	DinicAlgorithm       = "dinic"
+	PushRelabelAlgorithm = "push-relabel"
+)
+
+// Residual capacities below this amount are treated as saturated, so that rounding errors
+// in decimal capacities can not keep the algorithms running
+const flowEpsilon = 1e-9
+
+// Marks the nodes that a breadth-first search over the residual network did not reach
+const unreachableFlowHeight = -1
+
+// Represents an arc of the residual network; every arc is stored right before (or after) its reverse arc,
+// so the reverse of arc i is always arc i ^ 1
+type flowArc struct {
+	from     int
+	to       int
+	capacity float64
+	cost     float64
+	flow     float64
+	reversed bool
+	residual bool
+}
+
+func (a *flowArc) remaining() float64 {
+	return a.capacity - a.flow
+}
+
+// Builds the residual network of a graph, where every arc is paired with a reverse arc of no capacity;
+// the first weight of every edge is its capacity and the second one, if given, is the cost of a unit of flow
+func newResidualNetwork(g *graph) ([]flowArc, [][]int) {
+	arcs := make([]flowArc, 0, 2*g.edgeCount)
+	outgoing := make([][]int, g.n)
+
+	for node := range g.n {
+		for _, edge := range g.adjacencyList[node] {
+			cost := 0.0
+			if weights := edge.criteria(); len(weights) > 1 {
+				cost = weights[1]
+			}
+
+			outgoing[node] = append(outgoing[node], len(arcs))
+			arcs = append(arcs, flowArc{from: node, to: edge.node, capacity: edge.weight, cost: cost, reversed: edge.reversed})
+
+			outgoing[edge.node] = append(outgoing[edge.node], len(arcs))
+			arcs = append(arcs, flowArc{from: edge.node, to: node, cost: -cost, residual: true})
+		}
+	}
+
+	return arcs, outgoing
+}
+
+// Handles the problem solving logic
+type MaxFlowSolver struct {
+	graph     graph
+	algorithm string
+	source    int
+	sink      int
+	arcs      []flowArc
+	outgoing  [][]int
+	value     float64
+}
+
+func (s *MaxFlowSolver) Initialize(n int, edges []GraphEdge, directed bool, source NodeID, sink NodeID, algorithm string) error {
+	s.algorithm = algorithm
+	if s.algorithm == "" {
+		s.algorithm = DinicAlgorithm
+	}
+	if s.algorithm != DinicAlgorithm && s.algorithm != PushRelabelAlgorithm {
+		return fmt.Errorf("Unknown algorithm \"%s\". Supported algorithms are \"%s\" and \"%s\".", s.algorithm, DinicAlgorithm, PushRelabelAlgorithm)
+	}
+
+	for _, group := range edges {
+		if group.Weight < 0 {
+			return fmt.Errorf("Edge %s has a negative capacity: %s. Capacities must not be negative.", group.String(), formatWeight(group.Weight))
+		}
+	}
+
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{directed: directed})
+	if err != nil {
+		return err
+	}
+
+	s.source, err = s.graph.resolve(source)
+	if err != nil {
+		return fmt.Errorf("Invalid source node. %v", err)
+	}
+
+	s.sink, err = s.graph.resolve(sink)
+	if err != nil {
+		return fmt.Errorf("Invalid sink node. %v", err)
+	}
+
+	if s.source == s.sink {
+		return errors.New("Source and sink nodes must be different.")
+	}
+
+	s.arcs, s.outgoing = newResidualNetwork(&s.graph)
+	s.value = 0
+
+	return nil
+}
+
+func (s *MaxFlowSolver) Solve() error {
+	if s.algorithm == PushRelabelAlgorithm {
+		s.pushRelabel()
+	} else {
+		s.dinic()
+	}
+
+	if math.IsInf(s.value, 0) {
+		return errors.New("Flow value exceeds the range of floating-point numbers.")
+	}
+
+	return nil
+}
+
+// Sends the flow along an arc, updating its reverse arc accordingly
+func (s *MaxFlowSolver) push(arc int, amount float64) {
+	s.arcs[arc].flow += amount
+	s.arcs[arc^1].flow -= amount
+}
+
+// Repeatedly builds the level graph of the residual network with a breadth-first search,
+// then saturates it with a blocking flow found by depth-first searches
+func (s *MaxFlowSolver) dinic() {
+	for {
+		levels := s.levels()
+		if levels[s.sink] == unreachableFlowHeight {
+			return
+		}
+
+		// every node remembers the next arc to try, so that dead ends are never explored twice
+		next := make([]int, s.graph.n)
+		for {
+			pushed := s.augment(s.source, math.Inf(1), levels, next)
+			if pushed <= flowEpsilon {
+				break
+			}
+			s.value += pushed
+		}
+	}
+}
+
+// Computes the distance of every node from the source in the residual network
+func (s *MaxFlowSolver) levels() []int {
+	levels := make([]int, s.graph.n)
+	for i := range levels {
+		levels[i] = unreachableFlowHeight
+	}
+	levels[s.source] = 0
+
+	queue := []int{s.source}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, arc := range s.outgoing[node] {
+			to := s.arcs[arc].to
+			if levels[to] == unreachableFlowHeight && s.arcs[arc].remaining() > flowEpsilon {
+				levels[to] = levels[node] + 1
+				queue = append(queue, to)
+			}
+		}
+	}
+
+	return levels
+}
+
+// Finds a path towards the sink in the level graph, pushing as much flow along it as possible
+func (s *MaxFlowSolver) augment(node int, limit float64, levels []int, next []int) float64 {
+	if node == s.sink {
+		return limit
+	}
+
+	for ; next[node] < len(s.outgoing[node]); next[node]++ {
+		arc := s.outgoing[node][next[node]]
+		to := s.arcs[arc].to
+		if levels[to] != levels[node]+1 || s.arcs[arc].remaining() <= flowEpsilon {
+			continue
+		}
+
+		pushed := s.augment(to, min(limit, s.arcs[arc].remaining()), levels, next)
+		if pushed > flowEpsilon {
+			s.push(arc, pushed)
+			return pushed
+		}
+	}
+
+	return 0
+}
+
+// Saturates every arc leaving the source, then pushes the excess of every other node towards nodes
+// with a smaller height (relabeling it when there are none), until no node except the source and the sink
+// has any excess left; nodes are processed in FIFO order
+func (s *MaxFlowSolver) pushRelabel() {
+	n := s.graph.n
+	heights := make([]int, n)
+	excess := make([]float64, n)
+	next := make([]int, n)
+	active := make([]bool, n)
+	queue := make([]int, 0, n)
+
+	heights[s.source] = n
+	for _, arc := range s.outgoing[s.source] {
+		amount := s.arcs[arc].remaining()
+		if amount <= flowEpsilon {
+			continue
+		}
+
+		to := s.arcs[arc].to
+		s.push(arc, amount)
+		excess[to] += amount
+		excess[s.source] -= amount
+
+		if to != s.sink && !active[to] {
+			active[to] = true
+			queue = append(queue, to)
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		active[node] = false
+
+		for excess[node] > flowEpsilon {
+			if next[node] == len(s.outgoing[node]) {
+				// relabeling the node just above its lowest neighbor in the residual network
+				lowest := math.MaxInt
+				for _, arc := range s.outgoing[node] {
+					if s.arcs[arc].remaining() > flowEpsilon {
+						lowest = min(lowest, heights[s.arcs[arc].to])
+					}
+				}
+				heights[node] = lowest + 1
+				next[node] = 0
+				continue
+			}
+
+			arc := s.outgoing[node][next[node]]
+			to := s.arcs[arc].to
+			if s.arcs[arc].remaining() > flowEpsilon && heights[node] == heights[to]+1 {
+				amount := min(excess[node], s.arcs[arc].remaining())
+				s.push(arc, amount)
+				excess[node] -= amount
+				excess[to] += amount
+
+				if to != s.source && to != s.sink && !active[to] {
+					active[to] = true
+					queue = append(queue, to)
+				}
+			} else {
+				next[node]++
+			}
+		}
+	}
+
+	s.value = excess[s.sink]
+}
+
+// Finds the nodes that can still be reached from the source in the residual network,
+// which form the source side of a minimum cut
+func (s *MaxFlowSolver) sourceSide() []bool {
+	levels := s.levels()
+	reachable := make([]bool, s.graph.n)
+	for node, level := range levels {
+		reachable[node] = level != unreachableFlowHeight
+	}
+
+	return reachable
+}
+
+func (s *MaxFlowSolver) FormatResult() MaxFlowResult {
+	result := MaxFlowResult{}
+
+	result.Message = "Solution found"
+	result.Algorithm = s.algorithm
+	result.FlowValue = s.value
+	result.Solution = make([]MaxFlowResultEdge, 0, len(s.arcs)/2)
+	result.MinCut = MaxFlowResultCut{
+		SourceSide: make([]NodeID, 0),
+		SinkSide:   make([]NodeID, 0),
+		Edges:      make([]MaxFlowResultEdge, 0),
+	}
+	result.FormattedOutput = ""
+
+	reachable := s.sourceSide()
+	for node := range s.graph.n {
+		if reachable[node] {
+			result.MinCut.SourceSide = append(result.MinCut.SourceSide, s.graph.labels[node])
+		} else {
+			result.MinCut.SinkSide = append(result.MinCut.SinkSide, s.graph.labels[node])
+		}
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Maximum flow computed with the %s algorithm: %s\n", s.algorithm, formatWeight(s.value))
+	for _, arc := range s.arcs {
+		if arc.residual {
+			continue
+		}
+
+		edge := MaxFlowResultEdge{
+			From:     s.graph.labels[arc.from],
+			To:       s.graph.labels[arc.to],
+			Capacity: arc.capacity,
+			Flow:     max(0, arc.flow),
+			Reversed: arc.reversed,
+		}
+		result.Solution = append(result.Solution, edge)
+		result.FormattedOutput += fmt.Sprintf("Edge [%s, %s]: flow %s out of %s\n", edge.From, edge.To, formatWeight(edge.Flow), formatWeight(edge.Capacity))
+
+		if reachable[arc.from] && !reachable[arc.to] {
+			result.MinCut.Edges = append(result.MinCut.Edges, edge)
+		}
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Minimum cut: %v | %v\n", result.MinCut.SourceSide, result.MinCut.SinkSide)
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type MaxFlowResult struct {
+	Message         string              `json:"message"`
+	Algorithm       string              `json:"algorithm"`
+	FlowValue       float64             `json:"flow_value"`
+	Solution        []MaxFlowResultEdge `json:"solution"`
+	MinCut          MaxFlowResultCut    `json:"min_cut"`
+	FormattedOutput string              `json:"formatted_output"`
+}
+
+type MaxFlowResultEdge struct {
+	From     NodeID  `json:"from"`
+	To       NodeID  `json:"to"`
+	Capacity float64 `json:"capacity"`
+	Flow     float64 `json:"flow"`
+	Reversed bool    `json:"reversed"`
+}
+
+type MaxFlowResultCut struct {
+	SourceSide []NodeID            `json:"source_side"`
+	SinkSide   []NodeID            `json:"sink_side"`
+	Edges      []MaxFlowResultEdge `json:"edges"`
+}
@@ -0,0 +1,107 @@
+package solvers
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMaxFlow(t *testing.T) {
+	type Test struct {
+		n                  int
+		edges              [][3]int
+		directed           bool
+		source             int
+		sink               int
+		expectedFlow       float64
+		expectedSourceSide []int
+	}
+
+	tests := []Test{
+		{
+			n: 6,
+			edges: [][3]int{
+				{0, 1, 16}, {0, 2, 13}, {1, 3, 12},
+				{2, 1, 4}, {2, 4, 14}, {3, 2, 9},
+				{3, 5, 20}, {4, 3, 7}, {4, 5, 4},
+			},
+			directed:           true,
+			source:             0,
+			sink:               5,
+			expectedFlow:       23,
+			expectedSourceSide: []int{0, 1, 2, 4},
+		},
+		{
+			n:                  3,
+			edges:              [][3]int{{0, 1, 3}, {1, 2, 2}, {0, 2, 1}},
+			directed:           false,
+			source:             0,
+			sink:               2,
+			expectedFlow:       3,
+			expectedSourceSide: []int{0, 1},
+		},
+		{
+			n:                  4,
+			edges:              [][3]int{{0, 1, 5}, {2, 3, 5}},
+			directed:           true,
+			source:             0,
+			sink:               3,
+			expectedFlow:       0,
+			expectedSourceSide: []int{0, 1},
+		},
+	}
+
+	for testCount, test := range tests {
+		for _, algorithm := range []string{DinicAlgorithm, PushRelabelAlgorithm} {
+			solver := MaxFlowSolver{}
+			err := solver.Initialize(test.n, toGraphEdges(test.edges), test.directed, indexNode(test.source), indexNode(test.sink), algorithm)
+
+			// validating input data
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			// validating solution
+			err = solver.Solve()
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+			result := solver.FormatResult()
+
+			if result.FlowValue != test.expectedFlow {
+				t.Errorf("[%s] The flow value does not match the one expected.\nActual: %v\nExpected: %v", algorithm, result.FlowValue, test.expectedFlow)
+			}
+			if !validateArray(result.MinCut.SourceSide, toNodeIDs(test.expectedSourceSide)) {
+				t.Errorf("[%s] The source side of the cut does not match the one expected.\nActual: %v\nExpected: %v", algorithm, result.MinCut.SourceSide, test.expectedSourceSide)
+			}
+
+			// validating that the flow respects the capacities and is conserved at every node
+			balance := make([]float64, test.n)
+			for _, edge := range result.Solution {
+				if edge.Flow < 0 || edge.Flow > edge.Capacity {
+					t.Errorf("[%s] The flow on edge [%s, %s] is not within its capacity: %v", algorithm, edge.From, edge.To, edge.Flow)
+				}
+				balance[edge.From.index] -= edge.Flow
+				balance[edge.To.index] += edge.Flow
+			}
+			for node := range test.n {
+				if node != test.source && node != test.sink && balance[node] != 0 {
+					t.Errorf("[%s, Node %d] The flow is not conserved: %v", algorithm, node, balance[node])
+				}
+			}
+
+			cutCapacity := 0.0
+			for _, edge := range result.MinCut.Edges {
+				cutCapacity += edge.Capacity
+			}
+			if cutCapacity != test.expectedFlow {
+				t.Errorf("[%s] The capacity of the cut does not match the flow value.\nActual: %v\nExpected: %v", algorithm, cutCapacity, test.expectedFlow)
+			}
+
+			// print solution to help with debugging
+			fmt.Printf("------------------- Test %d (%s) -------------------\n", testCount+1, algorithm)
+			fmt.Printf("%s\n", result.FormattedOutput)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package solvers
+
+import (
+	"cmp"
+	"container/heap"
+	"fmt"
+	"slices"
+
+	"github.com/vanessahoamea/algorithms-api/src/utils"
+)
+
+// Algorithms that can be selected when solving a Minimum Spanning Tree problem instance
+const (
+	KruskalAlgorithm = "kruskal"
+	PrimAlgorithm    = "prim"
+)
+
+// Represents an edge of the spanning tree, in the orientation in which it was added
+type treeEdge struct {
+	from   int
+	to     int
+	weight float64
+}
+
+// Handles the problem solving logic
+type MinimumSpanningTreeSolver struct {
+	graph     graph
+	algorithm string
+	edges     []treeEdge
+}
+
+func (s *MinimumSpanningTreeSolver) Initialize(n int, edges []GraphEdge, algorithm string) error {
+	s.algorithm = algorithm
+	if s.algorithm == "" {
+		s.algorithm = KruskalAlgorithm
+	}
+	if s.algorithm != KruskalAlgorithm && s.algorithm != PrimAlgorithm {
+		return fmt.Errorf("Unknown algorithm \"%s\". Supported algorithms are \"%s\" and \"%s\".", s.algorithm, KruskalAlgorithm, PrimAlgorithm)
+	}
+
+	// spanning trees are only defined for undirected graphs
+	for _, group := range edges {
+		if group.Direction != "" && group.Direction != BidirectionalDirection {
+			return fmt.Errorf("Edge %s has a direction marker. Spanning trees are only defined for undirected graphs.", group.String())
+		}
+	}
+
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{directed: false, allowNegative: true})
+	if err != nil {
+		return err
+	}
+
+	s.edges = make([]treeEdge, 0, s.graph.n-1)
+
+	return nil
+}
+
+func (s *MinimumSpanningTreeSolver) Solve() {
+	if s.algorithm == PrimAlgorithm {
+		s.prim()
+	} else {
+		s.kruskal()
+	}
+}
+
+// Adds the edges in increasing order of their weights, skipping the ones that would close a cycle
+func (s *MinimumSpanningTreeSolver) kruskal() {
+	edges := make([]treeEdge, 0, s.graph.edgeCount/2)
+	for node := range s.graph.n {
+		for _, edge := range s.graph.adjacencyList[node] {
+			if !edge.reversed {
+				edges = append(edges, treeEdge{from: node, to: edge.node, weight: edge.weight})
+			}
+		}
+	}
+	slices.SortStableFunc(edges, func(a treeEdge, b treeEdge) int {
+		return cmp.Compare(a.weight, b.weight)
+	})
+
+	components := utils.NewUnionFind(s.graph.n)
+	for _, edge := range edges {
+		if components.Union(edge.from, edge.to) {
+			s.edges = append(s.edges, edge)
+		}
+
+		if components.Count == 1 {
+			break
+		}
+	}
+}
+
+// Grows a tree from every node that has not been reached yet, always adding the cheapest edge
+// that connects the tree to a new node
+func (s *MinimumSpanningTreeSolver) prim() {
+	visited := make([]bool, s.graph.n)
+
+	for root := range s.graph.n {
+		if visited[root] {
+			continue
+		}
+
+		pq := make(utils.PriorityQueue[treeEdge, float64], 0)
+		heap.Init(&pq)
+		heap.Push(&pq, &utils.Item[treeEdge, float64]{Value: treeEdge{from: -1, to: root}})
+
+		for pq.Len() > 0 {
+			edge := heap.Pop(&pq).(*utils.Item[treeEdge, float64]).Value
+			if visited[edge.to] {
+				continue
+			}
+			visited[edge.to] = true
+
+			if edge.from != -1 {
+				s.edges = append(s.edges, edge)
+			}
+
+			for _, next := range s.graph.adjacencyList[edge.to] {
+				if !visited[next.node] {
+					heap.Push(&pq, &utils.Item[treeEdge, float64]{
+						Value:    treeEdge{from: edge.to, to: next.node, weight: next.weight},
+						Priority: -next.weight,
+					})
+				}
+			}
+		}
+	}
+}
+
+func (s *MinimumSpanningTreeSolver) FormatResult() MinimumSpanningTreeResult {
+	result := MinimumSpanningTreeResult{}
+
+	result.Message = "Solution found"
+	result.Algorithm = s.algorithm
+	result.Solution = make([]MinimumSpanningTreeResultEdge, len(s.edges))
+	result.Components = make([]MinimumSpanningTreeResultComponent, 0)
+	result.FormattedOutput = ""
+
+	// numbering the components in the order of their smallest node
+	components := utils.NewUnionFind(s.graph.n)
+	for _, edge := range s.edges {
+		components.Union(edge.from, edge.to)
+	}
+
+	indices := make(map[int]int, components.Count)
+	for node := range s.graph.n {
+		root := components.Find(node)
+		if _, exists := indices[root]; !exists {
+			indices[root] = len(result.Components)
+			result.Components = append(result.Components, MinimumSpanningTreeResultComponent{
+				Nodes: make([]NodeID, 0),
+			})
+		}
+
+		component := &result.Components[indices[root]]
+		component.Nodes = append(component.Nodes, s.graph.labels[node])
+	}
+
+	for i, edge := range s.edges {
+		component := indices[components.Find(edge.from)]
+		result.Solution[i] = MinimumSpanningTreeResultEdge{
+			From:      s.graph.labels[edge.from],
+			To:        s.graph.labels[edge.to],
+			Weight:    edge.weight,
+			Component: component,
+		}
+
+		result.Components[component].Weight += edge.weight
+		result.Components[component].EdgeCount++
+		result.TotalWeight += edge.weight
+	}
+
+	if len(result.Components) > 1 {
+		result.Message = fmt.Sprintf("Graph is disconnected, minimum spanning forest found with %d components", len(result.Components))
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Spanning tree computed with the %s algorithm:\n", s.algorithm)
+	for _, edge := range result.Solution {
+		result.FormattedOutput += fmt.Sprintf("Edge [%s, %s] with weight %s\n", edge.From, edge.To, formatWeight(edge.Weight))
+	}
+	for i, component := range result.Components {
+		result.FormattedOutput += fmt.Sprintf("Component %d: nodes %v with total weight %s\n", i, component.Nodes, formatWeight(component.Weight))
+	}
+	result.FormattedOutput += fmt.Sprintf("Total weight: %s\n", formatWeight(result.TotalWeight))
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type MinimumSpanningTreeResult struct {
+	Message         string                               `json:"message"`
+	Algorithm       string                               `json:"algorithm"`
+	Solution        []MinimumSpanningTreeResultEdge      `json:"solution"`
+	Components      []MinimumSpanningTreeResultComponent `json:"components"`
+	TotalWeight     float64                              `json:"total_weight"`
+	FormattedOutput string                               `json:"formatted_output"`
+}
+
+type MinimumSpanningTreeResultEdge struct {
+	From      NodeID  `json:"from"`
+	To        NodeID  `json:"to"`
+	Weight    float64 `json:"weight"`
+	Component int     `json:"component"`
+}
+
+type MinimumSpanningTreeResultComponent struct {
+	Nodes     []NodeID `json:"nodes"`
+	Weight    float64  `json:"weight"`
+	EdgeCount int      `json:"edge_count"`
+}
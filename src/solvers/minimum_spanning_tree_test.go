@@ -0,0 +1,87 @@
+package solvers
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	type Test struct {
+		n                         int
+		edges                     [][3]int
+		expectedTotalWeight       float64
+		expectedComponentWeights  []float64
+		expectedComponentEdges    []int
+		expectedComponentForNodes []int
+	}
+
+	tests := []Test{
+		{
+			n: 7,
+			edges: [][3]int{
+				{0, 1, 7}, {0, 3, 5}, {1, 2, 8}, {1, 3, 9},
+				{1, 4, 7}, {2, 4, 5}, {3, 4, 15}, {3, 5, 6},
+				{4, 5, 8}, {4, 6, 9}, {5, 6, 11},
+			},
+			expectedTotalWeight:       39,
+			expectedComponentWeights:  []float64{39},
+			expectedComponentEdges:    []int{6},
+			expectedComponentForNodes: []int{0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			n: 7,
+			edges: [][3]int{
+				{0, 1, 4}, {1, 2, 3}, {0, 2, 1},
+				{3, 5, -2}, {5, 3, 6},
+				{6, 6, 1},
+			},
+			expectedTotalWeight:       2,
+			expectedComponentWeights:  []float64{4, -2, 0, 0},
+			expectedComponentEdges:    []int{2, 1, 0, 0},
+			expectedComponentForNodes: []int{0, 0, 0, 1, 2, 1, 3},
+		},
+	}
+
+	for testCount, test := range tests {
+		for _, algorithm := range []string{KruskalAlgorithm, PrimAlgorithm} {
+			solver := MinimumSpanningTreeSolver{}
+			err := solver.Initialize(test.n, toGraphEdges(test.edges), algorithm)
+
+			// validating input data
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			// validating solution
+			solver.Solve()
+			result := solver.FormatResult()
+
+			if result.TotalWeight != test.expectedTotalWeight {
+				t.Errorf("[%s] The total weight does not match the one expected.\nActual: %v\nExpected: %v", algorithm, result.TotalWeight, test.expectedTotalWeight)
+			}
+
+			weights := make([]float64, len(result.Components))
+			edgeCounts := make([]int, len(result.Components))
+			for i, component := range result.Components {
+				weights[i] = component.Weight
+				edgeCounts[i] = component.EdgeCount
+				for _, node := range component.Nodes {
+					if test.expectedComponentForNodes[node.index] != i {
+						t.Errorf("[%s, Node %s] The component does not match the one expected.\nActual: %d\nExpected: %d", algorithm, node, i, test.expectedComponentForNodes[node.index])
+					}
+				}
+			}
+			if !validateArray(weights, test.expectedComponentWeights) {
+				t.Errorf("[%s] The component weights do not match the ones expected.\nActual: %v\nExpected: %v", algorithm, weights, test.expectedComponentWeights)
+			}
+			if !validateArray(edgeCounts, test.expectedComponentEdges) {
+				t.Errorf("[%s] The component edge counts do not match the ones expected.\nActual: %v\nExpected: %v", algorithm, edgeCounts, test.expectedComponentEdges)
+			}
+
+			// print solution to help with debugging
+			fmt.Printf("------------------- Test %d (%s) -------------------\n", testCount+1, algorithm)
+			fmt.Printf("%s\n", result.FormattedOutput)
+		}
+	}
+}
@@ -3,13 +3,14 @@ package solvers
 import (
 	"fmt"
 	"maps"
-	"sort"
+	"slices"
 )
 
 // Represents a piece to be placed on the chessboard
 type queen struct {
 	row            int
 	col            int
+	given          bool
 	possibleValues queenDomain
 }
 
@@ -23,8 +24,9 @@ func (q *queen) initialize(col int, possibleValues queenDomain) {
 
 func (q *queen) cloneDeep() queen {
 	copy := queen{
-		row: q.row,
-		col: q.col,
+		row:   q.row,
+		col:   q.col,
+		given: q.given,
 	}
 
 	if q.possibleValues != nil {
@@ -35,46 +37,92 @@ func (q *queen) cloneDeep() queen {
 	return copy
 }
 
-func (q *queen) toString() string {
-	return fmt.Sprintf("(%d, %d)", q.row, q.col)
-}
-
-// Represents a chessboard instance
+// Represents a chessboard instance, where every column holds one piece of the same kind; boards with fewer rows
+// than columns are transposed, so that the pieces are placed in every row instead
 type chessboard struct {
-	n      int
-	queens []queen
+	n          int
+	shape      boardShape
+	transposed bool
+	piece      chessPiece
+	queens     []queen
 }
 
-func (c *chessboard) initialize(n int, blocked [][]int) error {
-	c.n = n
-	c.queens = make([]queen, n)
+func (c *chessboard) initialize(shape boardShape, piece chessPiece, blocked [][]int, fixed [][]int) error {
+	err := validateBlockedSquares(shape, blocked)
+	if err != nil {
+		return err
+	}
+
+	c.transposed = shape.rows < shape.cols
+	line := "column"
+	if c.transposed {
+		line = "row"
+	}
+	err = validateFixedPieces(shape, piece, blocked, fixed, line)
+	if err != nil {
+		return err
+	}
+
+	c.shape = shape
+	if c.transposed {
+		c.shape = shape.transpose()
+		blocked = transposeSquares(blocked)
+		fixed = transposeSquares(fixed)
+	}
+	c.n = c.shape.cols
+	c.piece = piece
+	c.queens = make([]queen, c.n)
 
-	for i := range n {
+	for i := range c.n {
 		possibleValues := make(queenDomain)
-		for j := range n {
+		for j := range c.shape.rows {
 			possibleValues[j] = true
 		}
 
-		for _, pair := range blocked {
-			if pair[0] < 0 || pair[0] >= n || pair[1] < 0 || pair[1] >= n {
-				return fmt.Errorf("Blocked pair is out of bounds: [%d, %d]. Row and column values belong to the interval [0, %d).", pair[0], pair[1], n)
-			}
-			if pair[1] == i {
-				delete(possibleValues, pair[0])
-			}
-		}
-
 		queen := queen{}
 		queen.initialize(i, possibleValues)
 		c.queens[i] = queen
 	}
 
+	for _, pair := range blocked {
+		delete(c.queens[pair[1]].possibleValues, pair[0])
+	}
+
+	// the fixed pieces can only stay on their squares, which are removed from the domains of the other pieces
+	for _, pair := range fixed {
+		row, col := pair[0], pair[1]
+		c.queens[col].possibleValues = queenDomain{row: true}
+		c.queens[col].given = true
+		c.assign(col, row)
+	}
+
 	return nil
 }
 
-func (c *chessboard) cloneDeep() chessboard {
+func transposeSquares(squares [][]int) [][]int {
+	transposed := make([][]int, len(squares))
+	for i, pair := range squares {
+		transposed[i] = []int{pair[1], pair[0]}
+	}
+
+	return transposed
+}
+
+// Returns the square as [row, col] on the board that was given, before it was transposed
+func (c *chessboard) square(row int, col int) (int, int) {
+	if c.transposed {
+		return col, row
+	}
+
+	return row, col
+}
+
+func (c *chessboard) cloneDeep() *chessboard {
 	copy := chessboard{
-		n: c.n,
+		n:          c.n,
+		shape:      c.shape,
+		transposed: c.transposed,
+		piece:      c.piece,
 	}
 
 	if c.queens != nil {
@@ -84,199 +132,249 @@ func (c *chessboard) cloneDeep() chessboard {
 		}
 	}
 
-	return copy
+	return &copy
 }
 
-// Handles the problem solving logic
-type NQueensSolver struct {
-	currentChessboard chessboard
-	queensOrder       map[int]int
-	queenDomains      map[int]queenDomain
-	iterations        int
-	solvable          bool
+func (c *chessboard) variableCount() int {
+	return c.n
 }
 
-func (s *NQueensSolver) Initialize(n int, blocked [][]int) error {
-	chessboard := chessboard{}
-	err := chessboard.initialize(n, blocked)
-
-	s.currentChessboard = chessboard
-	s.queensOrder = make(map[int]int)
-	s.queenDomains = make(map[int]queenDomain)
-	s.iterations = 0
-	s.solvable = true
-
-	return err
+func (c *chessboard) isAssigned(col int) bool {
+	return c.queens[col].row != -1
 }
 
-func (s *NQueensSolver) Solve() {
-	s.forwardChecking()
+func (c *chessboard) domain(col int) cspDomain {
+	return c.queens[col].possibleValues
 }
 
-func (s *NQueensSolver) forwardChecking() {
-	n := s.currentChessboard.n
-	beforeInitialization := make(map[int]chessboard)
-	verifiedQueens := 0
+// Places the piece of the given column on the given row, removing the squares it attacks
+// from the domains of the pieces that were not placed yet
+func (c *chessboard) assign(col int, row int) {
+	queens := c.queens
 
-	for {
-		if verifiedQueens < 0 || verifiedQueens >= n {
-			break
-		}
-
-		// selecting the queen with the fewest possible rows (MRV sorting)
-		sortedCopy := s.currentChessboard.cloneDeep()
-		sort.Slice(sortedCopy.queens, func(i, j int) bool {
-			return len(sortedCopy.queens[i].possibleValues) < len(sortedCopy.queens[j].possibleValues)
-		})
-
-		var col int
-		for i := range n {
-			if sortedCopy.queens[i].row == -1 {
-				col = sortedCopy.queens[i].col
-				break
-			}
-		}
-
-		row := s.selectValue(&s.currentChessboard, col)
-		nextChessboard := s.placeQueen(&s.currentChessboard, col, row)
-
-		if row == -1 {
-			verifiedQueens--
-			if verifiedQueens >= 0 {
-				board, exists := beforeInitialization[verifiedQueens]
-				if exists {
-					s.currentChessboard = board.cloneDeep()
-				}
-			}
-		} else {
-			// saving the order in which the queens were processed + their domains at the time of selection
-			s.queensOrder[verifiedQueens] = col
-			s.queenDomains[verifiedQueens] = make(queenDomain)
-			maps.Copy(s.queenDomains[verifiedQueens], s.currentChessboard.queens[col].possibleValues)
-
-			// removing the selected value from the queen's domain, to be able to backtrack if needed
-			delete(s.currentChessboard.queens[col].possibleValues, row)
-			beforeInitialization[verifiedQueens] = s.currentChessboard.cloneDeep()
-
-			verifiedQueens++
-			s.currentChessboard = nextChessboard
-		}
-
-		s.iterations++
-	}
-
-	if verifiedQueens == -1 {
-		s.solvable = false
+	if !queens[col].possibleValues[row] {
+		return
 	}
-}
-
-func (s *NQueensSolver) selectValue(chessboard *chessboard, col int) int {
-	chessboardCopy := chessboard.cloneDeep()
-	queen := chessboardCopy.queens[col]
 
-	for len(queen.possibleValues) > 0 {
-		var value int
-		for key := range queen.possibleValues {
-			value = key
-			break
-		}
-		emptyDomain := false
+	queens[col].row = row
 
-		nextChessboard := s.placeQueen(&chessboardCopy, col, value)
-		for _, newQueen := range nextChessboard.queens {
-			if len(newQueen.possibleValues) == 0 && newQueen.row == -1 {
-				emptyDomain = true
-				break
-			}
+	for other := range c.n {
+		if other == col || queens[other].row != -1 {
+			continue
 		}
 
-		if !emptyDomain {
-			return value
+		for _, attacked := range c.piece.attackedRows(c.shape, row, other-col) {
+			delete(queens[other].possibleValues, attacked)
 		}
-
-		delete(queen.possibleValues, value)
 	}
+}
 
-	return -1
+// Checks whether the board is a square torus whose size is divisible by 2 or 3, on which queens never have solutions,
+// as proven by Pólya; the search would otherwise go through every placement before giving up
+func (c *chessboard) modularlyUnsolvable() bool {
+	return c.shape.torus && c.shape.isSquare() && c.piece.movesLikeQueen() && c.n > 1 && (c.n%2 == 0 || c.n%3 == 0)
 }
 
-func (s *NQueensSolver) placeQueen(chessboard *chessboard, col int, row int) chessboard {
-	newChessboard := chessboard.cloneDeep()
+// Modes of the N-Queens problem: finding the first solution, counting all of them, listing them page by page,
+// or placing as many non-attacking pieces as possible anywhere on the board
+const (
+	FirstSolutionMode    = "first"
+	CountSolutionsMode   = "count"
+	AllSolutionsMode     = "all"
+	MaximumPlacementMode = "maximum"
+)
 
-	s.validateQueen(&newChessboard, col, row)
+// Strategies that can be selected when looking for the first solution of the N-Queens problem, besides forward checking
+const (
+	MinConflictsStrategy = "min-conflicts"
+)
 
-	return newChessboard
+// Represents the optional settings of the N-Queens problem
+type NQueensOptions struct {
+	Mode     string
+	Strategy string
+	Piece    string
+	// dimensions of the board, which default to n; min(rows, cols) pieces are placed
+	Rows     int
+	Cols     int
+	Topology string
+	// squares of the queens that are placed before the search, as [row, col] pairs
+	Fixed [][]int
+	// position from which the listing of solutions is resumed, as returned by a previous page
+	Cursor string
+	// maximum number of solutions returned on a page
+	Limit int
+	// whether the events of the search are recorded, and how many of them at most
+	Trace      bool
+	TraceLimit int
 }
 
-func (s *NQueensSolver) validateQueen(chessboard *chessboard, col int, row int) bool {
-	n := chessboard.n
-	queens := chessboard.queens
+// Handles the problem solving logic
+type NQueensSolver struct {
+	currentChessboard *chessboard
+	queensOrder       map[int]int
+	queenDomains      map[int]queenDomain
+	iterations        int
+	solvable          bool
+	strategy          string
+	mode              string
+	limit             int
+	position          []int
+	solutions         [][]int
+	total             int
+	fundamental       int
+	symmetric         bool
+	exhausted         bool
+	repair            *queenRepair
+	placement         *piecePlacement
+	trace             *cspTrace
+}
 
-	if !queens[col].possibleValues[row] {
-		return false
+func (s *NQueensSolver) Initialize(n int, blocked [][]int, options NQueensOptions) error {
+	s.queensOrder = make(map[int]int)
+	s.queenDomains = make(map[int]queenDomain)
+	s.iterations = 0
+	s.solvable = true
+	s.solutions = nil
+	s.total = 0
+	s.fundamental = 0
+	s.exhausted = false
+	s.repair = nil
+	s.placement = nil
+	s.trace = nil
+
+	piece, err := newChessPiece(options.Piece)
+	if err != nil {
+		return err
+	}
+	shape, err := newBoardShape(n, options.Rows, options.Cols, options.Topology)
+	if err != nil {
+		return err
 	}
 
-	queens[col].row = row
+	s.strategy = options.Strategy
+	if s.strategy == "" {
+		s.strategy = ForwardCheckingAlgorithm
+	}
+	err = s.initializeTrace(options)
+	if err != nil {
+		return err
+	}
+	if s.strategy == MinConflictsStrategy {
+		// the chessboard keeps a domain for every queen, which does not fit in memory for very large boards
+		s.currentChessboard = &chessboard{n: shape.cols, shape: shape, piece: piece}
+		return s.initializeRepair(blocked, options)
+	}
+	if s.strategy != ForwardCheckingAlgorithm {
+		return fmt.Errorf("Unknown strategy \"%s\". Supported strategies are \"%s\" and \"%s\".", s.strategy, ForwardCheckingAlgorithm, MinConflictsStrategy)
+	}
+	if options.Mode == MaximumPlacementMode {
+		// the pieces are not placed one in each column, so the chessboard only describes the board
+		s.currentChessboard = &chessboard{n: shape.cols, shape: shape, piece: piece}
+		return s.initializePlacement(blocked, options)
+	}
 
-	for i := range n {
-		if queens[i].possibleValues[row] && queens[i].row == -1 {
-			delete(queens[i].possibleValues, row)
-		}
+	chessboard := chessboard{}
+	err = chessboard.initialize(shape, piece, blocked, options.Fixed)
+	s.currentChessboard = &chessboard
+	if err != nil {
+		return err
+	}
 
-		// main diagonal
-		if row+i < n && col+i < n && queens[col+i].possibleValues[row+i] {
-			if queens[col+i].row == -1 {
-				delete(queens[col+i].possibleValues, row+i)
-			}
-		}
-		if row-i >= 0 && col-i >= 0 && queens[col-i].possibleValues[row-i] {
-			if queens[col-i].row == -1 {
-				delete(queens[col-i].possibleValues, row-i)
-			}
-		}
+	return s.initializeMode(options)
+}
 
-		// anti-diagonal
-		if row+i < n && col-i >= 0 && queens[col-i].possibleValues[row+i] {
-			if queens[col-i].row == -1 {
-				delete(queens[col-i].possibleValues, row+i)
-			}
-		}
-		if row-i >= 0 && col+i < n && queens[col+i].possibleValues[row-i] {
-			if queens[col+i].row == -1 {
-				delete(queens[col+i].possibleValues, row-i)
-			}
-		}
+func (s *NQueensSolver) Solve() {
+	switch s.mode {
+	case CountSolutionsMode:
+		s.countSolutions()
+		return
+	case AllSolutionsMode:
+		s.listSolutions()
+		return
+	case MaximumPlacementMode:
+		s.placeMaximum()
+		return
+	}
+	if s.strategy == MinConflictsStrategy {
+		s.repairQueens()
+		return
+	}
+
+	if s.currentChessboard.modularlyUnsolvable() {
+		s.solvable = false
+		return
 	}
 
-	return true
+	search := newForwardChecking(s.currentChessboard, 0)
+	search.trace = s.trace
+	search.run()
+
+	s.currentChessboard = search.current
+	s.queensOrder = search.order
+	s.queenDomains = search.domains
+	s.iterations = search.iterations
+	s.solvable = search.solvable
 }
 
 func (s *NQueensSolver) FormatResult() NQueensResult {
 	result := NQueensResult{}
 
+	result.Mode = s.mode
+	result.Piece = s.currentChessboard.piece.name
+	result.Rows, result.Cols = s.currentChessboard.shape.rows, s.currentChessboard.shape.cols
+	if s.currentChessboard.transposed {
+		result.Rows, result.Cols = result.Cols, result.Rows
+	}
+	result.Topology = FlatTopology
+	if s.currentChessboard.shape.torus {
+		result.Topology = TorusTopology
+	}
 	result.Iterations = s.iterations
-	result.Solution = make([]NQueensResultQueen, s.currentChessboard.n)
+	switch s.mode {
+	case CountSolutionsMode:
+		return s.formatCount(result)
+	case AllSolutionsMode:
+		return s.formatSolutions(result)
+	case MaximumPlacementMode:
+		return s.formatPlacement(result)
+	}
+
+	result.Strategy = s.strategy
+	if s.strategy == MinConflictsStrategy {
+		return s.formatRepair(result)
+	}
+
+	result.Solution = make([]NQueensResultQueen, 0, s.currentChessboard.n)
 	result.FormattedOutput = ""
+	if s.trace != nil {
+		result = s.formatTrace(result)
+	}
 
 	if s.solvable {
 		result.Message = "Solution found"
 
-		for index := range s.currentChessboard.n {
-			queenColumn := s.queensOrder[index]
-			queenDomain := make([]int, 0, len(s.queenDomains[index]))
-			for key, value := range s.queenDomains[index] {
-				if value {
-					queenDomain = append(queenDomain, key)
-				}
+		// the given queens are listed first, followed by the placed ones in the order they were processed
+		for _, queen := range s.currentChessboard.queens {
+			if queen.given {
+				row, col := s.currentChessboard.square(queen.row, queen.col)
+				result.Solution = append(result.Solution, NQueensResultQueen{Col: col, Row: row, Given: true})
+				result.FormattedOutput += fmt.Sprintf("%s %d: given -> (%d, %d)\n", s.currentChessboard.piece.title(), queen.col, row, col)
 			}
+		}
 
-			result.Solution[index] = NQueensResultQueen{
-				Col:    queenColumn,
-				Row:    s.currentChessboard.queens[queenColumn].row,
+		for index := range len(s.queensOrder) {
+			queenColumn := s.queensOrder[index]
+			queenDomain := slices.Sorted(maps.Keys(s.queenDomains[index]))
+			row, col := s.currentChessboard.square(s.currentChessboard.queens[queenColumn].row, queenColumn)
+
+			result.Solution = append(result.Solution, NQueensResultQueen{
+				Col:    col,
+				Row:    row,
 				Domain: queenDomain,
-			}
+			})
 
-			result.FormattedOutput += fmt.Sprintf("Queen %d: %v -> %s\n", queenColumn, queenDomain, s.currentChessboard.queens[queenColumn].toString())
+			result.FormattedOutput += fmt.Sprintf("%s %d: %v -> (%d, %d)\n", s.currentChessboard.piece.title(), queenColumn, queenDomain, row, col)
 		}
 	} else {
 		result.Message = "No solution"
@@ -287,14 +385,30 @@ func (s *NQueensSolver) FormatResult() NQueensResult {
 
 // Represents the final solution obtained after running the algorithm
 type NQueensResult struct {
-	Message         string               `json:"message"`
-	Iterations      int                  `json:"iterations"`
-	Solution        []NQueensResultQueen `json:"solution"`
-	FormattedOutput string               `json:"formatted_output"`
+	Message              string               `json:"message"`
+	Mode                 string               `json:"mode"`
+	Piece                string               `json:"piece"`
+	Rows                 int                  `json:"rows"`
+	Cols                 int                  `json:"cols"`
+	Topology             string               `json:"topology"`
+	Strategy             string               `json:"strategy,omitempty"`
+	Iterations           int                  `json:"iterations"`
+	RepairSteps          int                  `json:"repair_steps,omitempty"`
+	Placed               int                  `json:"placed"`
+	Optimal              bool                 `json:"optimal,omitempty"`
+	Solution             []NQueensResultQueen `json:"solution"`
+	TotalSolutions       int                  `json:"total_solutions"`
+	FundamentalSolutions int                  `json:"fundamental_solutions,omitempty"`
+	Solutions            [][]int              `json:"solutions,omitempty"`
+	NextCursor           string               `json:"next_cursor,omitempty"`
+	Trace                []NQueensTraceEvent  `json:"trace,omitempty"`
+	TraceTruncated       bool                 `json:"trace_truncated,omitempty"`
+	FormattedOutput      string               `json:"formatted_output"`
 }
 
 type NQueensResultQueen struct {
 	Col    int   `json:"col"`
 	Row    int   `json:"row"`
-	Domain []int `json:"domain"`
+	Domain []int `json:"domain,omitempty"`
+	Given  bool  `json:"given"`
 }
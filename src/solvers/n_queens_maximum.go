@@ -0,0 +1,265 @@
+package solvers
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Limits the boards on which the maximum placement is searched exhaustively, since every square must fit in a bitmask,
+// as well as the number of iterations of the search and the size of the boards filled with known placements
+const (
+	maxSearchedSquares     = 64
+	maxPlacementIterations = 10000000
+	maxPlacementBoard      = 1000
+)
+
+// Represents a placement of as many pieces as possible on the board, anywhere and in any number per column
+type piecePlacement struct {
+	shape      boardShape
+	piece      chessPiece
+	blocked    map[[2]int]bool
+	fixed      map[[2]int]bool
+	squares    [][2]int
+	optimal    bool
+	iterations int
+}
+
+func (s *NQueensSolver) initializePlacement(blocked [][]int, options NQueensOptions) error {
+	piece, shape := s.currentChessboard.piece, s.currentChessboard.shape
+	s.placement = &piecePlacement{
+		shape:   shape,
+		piece:   piece,
+		blocked: make(map[[2]int]bool, len(blocked)),
+		fixed:   make(map[[2]int]bool, len(options.Fixed)),
+	}
+
+	err := validateBlockedSquares(shape, blocked)
+	if err != nil {
+		return err
+	}
+	for _, pair := range blocked {
+		s.placement.blocked[[2]int{pair[0], pair[1]}] = true
+	}
+
+	err = validateFixedPieces(shape, piece, blocked, options.Fixed, "")
+	if err != nil {
+		return err
+	}
+	for _, pair := range options.Fixed {
+		s.placement.fixed[[2]int{pair[0], pair[1]}] = true
+	}
+
+	// larger boards are filled with the placements known to be optimal, which only exist for flat square boards
+	// and do not account for blocked or fixed squares
+	if shape.rows*shape.cols > maxSearchedSquares {
+		if len(blocked) > 0 || len(options.Fixed) > 0 || !piece.isStandard() || !shape.isSquare() || shape.torus {
+			return fmt.Errorf("Boards with more than %d squares only support standard pieces on flat square boards without blocked or fixed squares in the \"%s\" mode.", maxSearchedSquares, MaximumPlacementMode)
+		}
+		if shape.rows > maxPlacementBoard {
+			return fmt.Errorf("Board size must be at most %d in the \"%s\" mode, got %d.", maxPlacementBoard, MaximumPlacementMode, shape.rows)
+		}
+	}
+
+	return s.initializeMode(options)
+}
+
+func (s *NQueensSolver) placeMaximum() {
+	p := s.placement
+	if p.shape.rows*p.shape.cols > maxSearchedSquares {
+		p.construct()
+	} else {
+		p.search()
+	}
+
+	s.iterations = p.iterations
+	s.exhausted = !p.optimal
+	s.solvable = len(p.squares) > 0
+}
+
+// Fills the board with a placement of standard pieces whose size is known to be the largest possible:
+// n rooks or queens (one in each row and column), 2n - 2 bishops on the first and last columns,
+// knights on every square of the same color, and kings on every other square of every other row
+func (p *piecePlacement) construct() {
+	n := p.shape.rows
+	p.squares = make([][2]int, 0)
+
+	switch p.piece.name {
+	case QueenPiece:
+		for col, row := range explicitQueens(n) {
+			p.squares = append(p.squares, [2]int{row, col})
+		}
+	case RookPiece:
+		for i := range n {
+			p.squares = append(p.squares, [2]int{i, i})
+		}
+	case BishopPiece:
+		for row := range n {
+			p.squares = append(p.squares, [2]int{row, 0})
+			if row > 0 && row < n-1 {
+				p.squares = append(p.squares, [2]int{row, n - 1})
+			}
+		}
+	default:
+		for row := range n {
+			for col := range n {
+				knight := p.piece.knight && (row+col)%2 == 0
+				king := p.piece.king && row%2 == 0 && col%2 == 0
+				if knight || king {
+					p.squares = append(p.squares, [2]int{row, col})
+				}
+			}
+		}
+	}
+
+	p.optimal = true
+}
+
+// Returns the row of the queen in every column of the explicit N-Queens solution that exists for every n > 3:
+// the even rows followed by the odd ones, with a few of them moved around when n leaves a remainder of 2 or 3 modulo 6
+func explicitQueens(n int) []int {
+	evens, odds := make([]int, 0, n), make([]int, 0, n)
+	for row := 1; row < n; row += 2 {
+		evens = append(evens, row)
+	}
+	for row := 0; row < n; row += 2 {
+		odds = append(odds, row)
+	}
+
+	// the rows are counted from 1 in the usual statement of the construction, so the even rows have odd indices
+	switch n % 6 {
+	case 2:
+		odds = append(append([]int{2, 0}, odds[3:]...), 4)
+	case 3:
+		evens = append(evens[1:], 1)
+		odds = append(odds[2:], 0, 2)
+	}
+
+	return append(evens, odds...)
+}
+
+// Searches for the largest placement with branch and bound, where the squares that can still receive a piece are kept
+// as a bitmask: the first of them either receives a piece, removing the squares it attacks, or stays empty; the search
+// stops early when even covering the remaining squares with groups of squares that attack each other (of which at most
+// one can receive a piece) cannot beat the best placement found so far
+func (p *piecePlacement) search() {
+	n, squares := p.shape.cols, p.shape.rows*p.shape.cols
+	attacks := make([]uint64, squares)
+	candidates, placed := uint64(0), uint64(0)
+
+	for square := range squares {
+		row, col := square/n, square%n
+		for other := range squares {
+			if p.piece.attacks(p.shape, row, col, other/n, other%n) {
+				attacks[square] |= 1 << other
+			}
+		}
+		if !p.blocked[[2]int{row, col}] {
+			candidates |= 1 << square
+		}
+	}
+
+	for square := range squares {
+		if p.fixed[[2]int{square / n, square % n}] {
+			placed |= 1 << square
+			candidates &^= attacks[square] | 1<<square
+		}
+	}
+
+	best, bestPlaced := -1, uint64(0)
+	var branch func(candidates uint64, placed uint64)
+	branch = func(candidates uint64, placed uint64) {
+		if p.iterations >= maxPlacementIterations {
+			return
+		}
+		p.iterations++
+
+		count := bits.OnesCount64(placed)
+		if candidates == 0 {
+			if count > best {
+				best, bestPlaced = count, placed
+			}
+			return
+		}
+		if count+cliqueCover(candidates, attacks) <= best {
+			return
+		}
+
+		square := uint64(1) << bits.TrailingZeros64(candidates)
+		branch(candidates&^(attacks[bits.TrailingZeros64(candidates)]|square), placed|square)
+		branch(candidates&^square, placed)
+	}
+	branch(candidates, placed)
+
+	p.optimal = p.iterations < maxPlacementIterations
+	p.squares = make([][2]int, 0, best)
+	for bestPlaced != 0 {
+		square := bits.TrailingZeros64(bestPlaced)
+		bestPlaced &= bestPlaced - 1
+		p.squares = append(p.squares, [2]int{square / n, square % n})
+	}
+}
+
+// Covers the squares greedily with groups in which every two squares attack each other, returning the number of groups
+func cliqueCover(candidates uint64, attacks []uint64) int {
+	groups := 0
+	for candidates != 0 {
+		square := bits.TrailingZeros64(candidates)
+		group := uint64(1) << square
+		candidates &^= group
+
+		neighbors := candidates & attacks[square]
+		for neighbors != 0 {
+			other := bits.TrailingZeros64(neighbors)
+			neighbors &^= 1 << other
+			if attacks[other]&group == group {
+				group |= 1 << other
+				candidates &^= 1 << other
+				neighbors &= attacks[other]
+			}
+		}
+
+		groups++
+	}
+
+	return groups
+}
+
+func (s *NQueensSolver) formatPlacement(result NQueensResult) NQueensResult {
+	p := s.placement
+	result.Placed = len(p.squares)
+	result.Optimal = p.optimal
+	result.Solution = make([]NQueensResultQueen, 0, len(p.squares))
+
+	if p.optimal {
+		result.Message = fmt.Sprintf("Placed %d %ss, which is the maximum", len(p.squares), p.piece.name)
+	} else {
+		result.Message = fmt.Sprintf("Placed %d %ss, but the maximum could not be proven within %d iterations", len(p.squares), p.piece.name, maxPlacementIterations)
+	}
+
+	// drawing the board, where the fixed pieces are lowercase and the blocked squares are marked with #
+	board := make([][]string, p.shape.rows)
+	for row := range board {
+		board[row] = strings.Split(strings.Repeat(".", p.shape.cols), "")
+	}
+	for square := range p.blocked {
+		board[square[0]][square[1]] = "#"
+	}
+	for _, square := range p.squares {
+		given := p.fixed[square]
+		result.Solution = append(result.Solution, NQueensResultQueen{Col: square[1], Row: square[0], Given: given})
+
+		board[square[0]][square[1]] = p.piece.symbol
+		if given {
+			board[square[0]][square[1]] = strings.ToLower(p.piece.symbol)
+		}
+	}
+
+	output := strings.Builder{}
+	for _, row := range board {
+		output.WriteString(strings.Join(row, " ") + "\n")
+	}
+	result.FormattedOutput = output.String()
+
+	return result
+}
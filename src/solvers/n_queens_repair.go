@@ -0,0 +1,347 @@
+package solvers
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// Limits the size of the boards solved with the min-conflicts strategy, as well as the number of swaps
+// that are tried while repairing the placement
+const (
+	maxRepairedQueens = 1000000
+	maxRepairAttempts = 20000000
+)
+
+// Number of random rows tried for every queen when building the initial placement, and number of steps
+// tried when building the initial placement of a torus
+const (
+	initialPlacementTries = 32
+	modularStepTries      = 1000
+)
+
+// Represents a complete placement of the queens, one in each column and on distinct rows, where the number of queens
+// on every diagonal is kept so that the conflicts of a square can be counted in constant time
+type queenRepair struct {
+	n          int
+	diagonal   bool
+	torus      bool
+	rows       []int
+	blocked    map[[2]int]bool
+	fixed      map[int]int
+	ascending  []int
+	descending []int
+	random     *rand.Rand
+	steps      int
+}
+
+func (s *NQueensSolver) initializeRepair(blocked [][]int, options NQueensOptions) error {
+	if options.Mode != "" && options.Mode != FirstSolutionMode {
+		return fmt.Errorf("The \"%s\" strategy can only be used to find the first solution.", MinConflictsStrategy)
+	}
+	piece, shape := s.currentChessboard.piece, s.currentChessboard.shape
+	if !shape.isSquare() {
+		return fmt.Errorf("The \"%s\" strategy only supports square boards, got %dx%d.", MinConflictsStrategy, shape.rows, shape.cols)
+	}
+	n := shape.rows
+	if piece.knight || piece.king || !piece.straight {
+		return fmt.Errorf("The \"%s\" strategy only supports pieces that move along rows, columns and diagonals, such as queens and rooks.", MinConflictsStrategy)
+	}
+	if n > maxRepairedQueens {
+		return fmt.Errorf("The \"%s\" strategy supports at most %d queens, got %d.", MinConflictsStrategy, maxRepairedQueens, n)
+	}
+
+	s.repair = &queenRepair{
+		n:          n,
+		diagonal:   piece.diagonal,
+		torus:      shape.torus,
+		rows:       make([]int, n),
+		blocked:    make(map[[2]int]bool, len(blocked)),
+		fixed:      make(map[int]int, len(options.Fixed)),
+		ascending:  make([]int, 2*n),
+		descending: make([]int, 2*n),
+		// a fixed seed makes the placement reproducible
+		random: rand.New(rand.NewPCG(uint64(n), 0)),
+	}
+
+	err := validateBlockedSquares(shape, blocked)
+	if err != nil {
+		return err
+	}
+	for _, pair := range blocked {
+		s.repair.blocked[[2]int{pair[0], pair[1]}] = true
+	}
+
+	err = validateFixedPieces(shape, piece, blocked, options.Fixed, "column")
+	if err != nil {
+		return err
+	}
+	for _, pair := range options.Fixed {
+		s.repair.fixed[pair[1]] = pair[0]
+	}
+
+	return s.initializeMode(options)
+}
+
+// Returns the diagonal and the anti-diagonal of the square, which wrap around the edges of a torus
+func (r *queenRepair) diagonals(row int, col int) (int, int) {
+	if r.torus {
+		return (row + col) % r.n, (row - col + r.n) % r.n
+	}
+
+	return row + col, row - col + r.n
+}
+
+// Counts the conflicts of a queen placed on the square: the other queens on its diagonals (unless the pieces are rooks),
+// plus one if the square is blocked
+func (r *queenRepair) conflicts(row int, col int) int {
+	conflicts := 0
+	if r.diagonal {
+		up, down := r.diagonals(row, col)
+		conflicts += r.ascending[up] + r.descending[down]
+	}
+	if r.blocked[[2]int{row, col}] {
+		conflicts++
+	}
+
+	return conflicts
+}
+
+func (r *queenRepair) move(col int, row int, count int) {
+	up, down := r.diagonals(row, col)
+	r.ascending[up] += count
+	r.descending[down] += count
+}
+
+func (r *queenRepair) attacked(col int) bool {
+	row := r.rows[col]
+	r.move(col, row, -1)
+	conflicts := r.conflicts(row, col)
+	r.move(col, row, 1)
+
+	return conflicts > 0
+}
+
+// Checks whether some row or column is blocked entirely, in which case no placement exists
+func (r *queenRepair) fullyBlocked() bool {
+	rows := make(map[int]int)
+	cols := make(map[int]int)
+	for square := range r.blocked {
+		rows[square[0]]++
+		cols[square[1]]++
+		if rows[square[0]] == r.n || cols[square[1]] == r.n {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Places the fixed queens on their squares, then the other queens column by column on the rows that are still unused,
+// preferring the ones that are allowed and not attacked diagonally; only a few random rows are tried, so the placement
+// is built in linear time and just a few queens are left in conflict
+func (r *queenRepair) place() {
+	if r.torus && r.diagonal {
+		r.placeModular()
+		return
+	}
+
+	fixedRows := make(map[int]bool, len(r.fixed))
+	for col, row := range r.fixed {
+		r.rows[col] = row
+		r.move(col, row, 1)
+		fixedRows[row] = true
+	}
+
+	unused := make([]int, 0, r.n-len(r.fixed))
+	for row := range r.n {
+		if !fixedRows[row] {
+			unused = append(unused, row)
+		}
+	}
+
+	for col := range r.n {
+		if _, exists := r.fixed[col]; exists {
+			continue
+		}
+
+		selected, fewest := -1, 0
+		for try := 0; try < initialPlacementTries; try++ {
+			index := r.random.IntN(len(unused))
+			conflicts := r.conflicts(unused[index], col)
+			if selected == -1 || conflicts < fewest {
+				selected, fewest = index, conflicts
+			}
+			if conflicts == 0 {
+				break
+			}
+		}
+
+		r.rows[col] = unused[selected]
+		r.move(col, r.rows[col], 1)
+		unused[selected] = unused[len(unused)-1]
+		unused = unused[:len(unused)-1]
+	}
+}
+
+// Places the queens of a torus on the rows (step * col + shift) mod n, which never attack each other when the step, the step - 1
+// and the step + 1 have no common divisors with n, since random placements of queens on a torus are rarely repaired;
+// the step keeps the most fixed queens on their squares and the shift avoids the most blocked squares,
+// then the other fixed queens are swapped onto their rows
+func (r *queenRepair) placeModular() {
+	fixedCols := slices.Sorted(maps.Keys(r.fixed))
+	step, shift := 2, 0
+
+	if len(fixedCols) == 0 {
+		hits := make([]int, r.n)
+		for square := range r.blocked {
+			hits[modulo(square[0]-2*square[1], r.n)]++
+		}
+		shift = slices.Index(hits, slices.Min(hits))
+	}
+
+	// the shift keeps the first fixed queen on its square, so only the steps are tried
+	best, bestHits := -1, 0
+	for candidate, tries := 2, 0; len(fixedCols) > 0 && candidate < r.n && tries < modularStepTries; candidate++ {
+		if gcd(candidate, r.n) != 1 || gcd(candidate-1, r.n) != 1 || gcd(candidate+1, r.n) != 1 {
+			continue
+		}
+		tries++
+
+		candidateShift := modulo(r.fixed[fixedCols[0]]-candidate*fixedCols[0], r.n)
+		matched, hits := 0, 0
+		for col, row := range r.fixed {
+			if modulo(candidate*col+candidateShift, r.n) == row {
+				matched++
+			}
+		}
+		for square := range r.blocked {
+			if modulo(candidate*square[1]+candidateShift, r.n) == square[0] {
+				hits++
+			}
+		}
+
+		if matched > best || (matched == best && hits < bestHits) {
+			step, shift, best, bestHits = candidate, candidateShift, matched, hits
+		}
+	}
+
+	cols := make([]int, r.n)
+	for col := range r.n {
+		r.rows[col] = modulo(step*col+shift, r.n)
+		cols[r.rows[col]] = col
+	}
+	for _, col := range fixedCols {
+		row, other := r.fixed[col], cols[r.fixed[col]]
+		r.rows[col], r.rows[other] = row, r.rows[col]
+		cols[row], cols[r.rows[other]] = col, other
+	}
+	for col, row := range r.rows {
+		r.move(col, row, 1)
+	}
+}
+
+// Counts the conflicts of the queens of two columns, as if they were placed on the given rows
+func (r *queenRepair) pairConflicts(col int, row int, other int, otherRow int) int {
+	conflicts := r.conflicts(row, col) + r.conflicts(otherRow, other)
+	up, down := r.diagonals(row, col)
+	otherUp, otherDown := r.diagonals(otherRow, other)
+	if r.diagonal && (up == otherUp || down == otherDown) {
+		conflicts++
+	}
+
+	return conflicts
+}
+
+// Repairs the placement with the min-conflicts heuristic: the rows of a random queen in conflict and of another
+// random queen that is not fixed are swapped whenever that does not increase their conflicts, until no queen is attacked;
+// every pair of attacking queens keeps at least one of them in the list of candidates, since the swapped queens
+// are added to the list again whenever they are attacked
+func (s *NQueensSolver) repairQueens() {
+	r := s.repair
+	if r.fullyBlocked() || (r.diagonal && (r.n == 2 || r.n == 3)) || s.currentChessboard.modularlyUnsolvable() {
+		s.solvable = false
+		return
+	}
+	r.place()
+
+	candidates := make([]int, 0)
+	for col := range r.n {
+		if r.attacked(col) {
+			candidates = append(candidates, col)
+		}
+	}
+
+	for attempts := 0; len(candidates) > 0; attempts++ {
+		index := r.random.IntN(len(candidates))
+		col := candidates[index]
+		// fixed queens never move, and the queens attacking them are candidates themselves
+		_, fixed := r.fixed[col]
+		if fixed || !r.attacked(col) {
+			candidates[index] = candidates[len(candidates)-1]
+			candidates = candidates[:len(candidates)-1]
+			continue
+		}
+
+		if attempts >= maxRepairAttempts {
+			s.exhausted = true
+			break
+		}
+
+		other := r.random.IntN(r.n)
+		if _, fixed := r.fixed[other]; fixed || other == col {
+			continue
+		}
+
+		// comparing the conflicts of both queens before and after the swap, with both of them lifted off the board
+		row, otherRow := r.rows[col], r.rows[other]
+		r.move(col, row, -1)
+		r.move(other, otherRow, -1)
+
+		if r.pairConflicts(col, otherRow, other, row) <= r.pairConflicts(col, row, other, otherRow) {
+			r.rows[col], r.rows[other] = otherRow, row
+			r.steps++
+			candidates = append(candidates, other)
+		}
+
+		r.move(col, r.rows[col], 1)
+		r.move(other, r.rows[other], 1)
+	}
+
+	s.iterations = r.steps
+	s.solvable = len(candidates) == 0
+}
+
+func (s *NQueensSolver) formatRepair(result NQueensResult) NQueensResult {
+	r := s.repair
+	result.RepairSteps = r.steps
+	result.Solution = []NQueensResultQueen{}
+
+	if !s.solvable {
+		result.Message = "No solution"
+		if s.exhausted {
+			result.Message = fmt.Sprintf("No solution found within %d repair attempts", maxRepairAttempts)
+		}
+		return result
+	}
+
+	result.Message = fmt.Sprintf("Solution found after %d repair steps", r.steps)
+	result.Solution = make([]NQueensResultQueen, r.n)
+
+	// the output of very large boards is built without copying it for every queen
+	output := strings.Builder{}
+	for col, row := range r.rows {
+		_, given := r.fixed[col]
+		result.Solution[col] = NQueensResultQueen{Col: col, Row: row, Given: given}
+		if given {
+			fmt.Fprintf(&output, "%s %d: given -> (%d, %d)\n", s.currentChessboard.piece.title(), col, row, col)
+		} else {
+			fmt.Fprintf(&output, "%s %d -> (%d, %d)\n", s.currentChessboard.piece.title(), col, row, col)
+		}
+	}
+	result.FormattedOutput = output.String()
+
+	return result
+}
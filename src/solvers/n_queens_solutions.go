@@ -0,0 +1,410 @@
+package solvers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Limits the size of the boards whose solutions are counted, since their number grows exponentially,
+// as well as the number of steps spent counting them; the solutions of a torus are counted by listing them,
+// which takes longer for every step, so they get a smaller budget that fits within the timeout of a request
+const (
+	maxCountedQueens      = 16
+	maxCountingSteps      = 1000000000
+	maxTorusCountingSteps = 10000000
+)
+
+// Limits the number of solutions on a page, as well as the number of steps spent looking for them
+const (
+	defaultSolutionsLimit = 10
+	maxSolutionsLimit     = 1000
+	maxListingSteps       = 10000000
+)
+
+func (s *NQueensSolver) initializeMode(options NQueensOptions) error {
+	s.mode = options.Mode
+	if s.mode == "" {
+		s.mode = FirstSolutionMode
+	}
+	if !slices.Contains([]string{FirstSolutionMode, CountSolutionsMode, AllSolutionsMode, MaximumPlacementMode}, s.mode) {
+		return fmt.Errorf("Unknown mode \"%s\". Supported modes are \"%s\", \"%s\", \"%s\" and \"%s\".", s.mode, FirstSolutionMode, CountSolutionsMode, AllSolutionsMode, MaximumPlacementMode)
+	}
+
+	shape := s.currentChessboard.shape
+	if s.mode == CountSolutionsMode && shape.rows > maxCountedQueens {
+		return fmt.Errorf("Solutions can only be counted on boards with at most %d rows and columns, got %dx%d.", maxCountedQueens, shape.rows, shape.cols)
+	}
+	if s.mode != AllSolutionsMode && (options.Cursor != "" || options.Limit != 0) {
+		return fmt.Errorf("Cursor and limit can only be used in the \"%s\" mode.", AllSolutionsMode)
+	}
+
+	s.limit = options.Limit
+	if s.limit == 0 {
+		s.limit = defaultSolutionsLimit
+	}
+	if s.limit < 0 || s.limit > maxSolutionsLimit {
+		return fmt.Errorf("Limit must belong to the interval [1, %d], got %d.", maxSolutionsLimit, s.limit)
+	}
+
+	s.position = []int{0}
+	if options.Cursor != "" {
+		position, err := s.decodeCursor(options.Cursor)
+		if err != nil {
+			return err
+		}
+		s.position = position
+	}
+
+	return nil
+}
+
+// Encodes a position of the search as the rows of the queens placed so far, followed by the next row to try
+func encodeCursor(position []int) string {
+	rows := make([]string, len(position))
+	for i, row := range position {
+		rows[i] = strconv.Itoa(row)
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(rows, ",")))
+}
+
+func (s *NQueensSolver) decodeCursor(cursor string) ([]int, error) {
+	invalid := fmt.Errorf("Cursor \"%s\" is not valid for this board. Cursors are returned by previous pages of the same problem.", cursor)
+	board := s.currentChessboard
+	n := board.n
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, invalid
+	}
+
+	values := strings.Split(string(decoded), ",")
+	if len(values) > n {
+		return nil, invalid
+	}
+
+	position := make([]int, len(values))
+	for col, value := range values {
+		position[col], err = strconv.Atoi(value)
+		if err != nil || position[col] < 0 || position[col] > board.shape.rows {
+			return nil, invalid
+		}
+	}
+
+	// the pieces placed before the last column must be allowed on their squares and must not attack each other
+	placed := position[:len(position)-1]
+	for col, row := range placed {
+		if row == board.shape.rows || !board.queens[col].possibleValues[row] {
+			return nil, invalid
+		}
+		for other := range col {
+			if board.piece.attacks(board.shape, placed[other], other, row, col) {
+				return nil, invalid
+			}
+		}
+	}
+
+	return position, nil
+}
+
+// Counts the solutions with bitmask backtracking, where the rows attacked in the current column are kept as bits;
+// queens on square boards without blocked squares are symmetric, so only the solutions with the first queen in the upper half
+// are counted and the fundamental solutions (distinct up to rotations and reflections) are derived from their symmetries.
+// The attacks of a torus wrap around the board, so its solutions are counted by listing them instead
+func (s *NQueensSolver) countSolutions() {
+	board := s.currentChessboard
+	if board.shape.torus {
+		s.listSolutions()
+		return
+	}
+
+	n := board.n
+	counter := queenCounter{
+		n:       n,
+		piece:   board.piece,
+		full:    uint64(1)<<board.shape.rows - 1,
+		allowed: make([]uint64, n),
+		rows:    make([]int, n),
+	}
+
+	s.symmetric = board.shape.isSquare() && counter.piece.movesLikeQueen()
+	for col, queen := range board.queens {
+		for row := range queen.possibleValues {
+			counter.allowed[col] |= 1 << row
+		}
+		s.symmetric = s.symmetric && counter.allowed[col] == counter.full
+	}
+
+	if !s.symmetric || n == 1 {
+		counter.place(0, 0, 0, 0, 0, 0)
+		s.total = counter.total
+		s.fundamental = counter.total
+		s.iterations = counter.iterations
+		s.exhausted = counter.iterations >= maxCountingSteps
+		return
+	}
+
+	// the mirror image of every solution has its first queen in the other half of the column
+	half := uint64(1)<<(n/2) - 1
+	counter.checkSymmetry = true
+	counter.allowed[0] = half
+	counter.place(0, 0, 0, 0, 0, 0)
+
+	// for odd sizes, the first queen can also be on the middle row, in which case the second queen is placed in the upper half
+	if n%2 == 1 {
+		counter.allowed[0] = 1 << (n / 2)
+		counter.allowed[1] = half
+		counter.place(0, 0, 0, 0, 0, 0)
+	}
+
+	// a solution is never symmetric to itself through a reflection, so by Burnside's lemma the number of fundamental solutions
+	// is the average number of solutions kept unchanged by the rotations
+	s.total = 2 * counter.total
+	s.fundamental = (s.total + 2*2*counter.rotation90 + 2*counter.rotation180) / 8
+	s.iterations = counter.iterations
+	s.exhausted = counter.iterations >= maxCountingSteps
+}
+
+// Represents the state of the bitmask backtracking
+type queenCounter struct {
+	n             int
+	piece         chessPiece
+	full          uint64
+	allowed       []uint64
+	rows          []int
+	checkSymmetry bool
+	total         int
+	rotation90    int
+	rotation180   int
+	iterations    int
+}
+
+// Places a piece in the given column on one of the rows that are allowed and not attacked by the previous pieces,
+// where the diagonals attacked in the next column are obtained by shifting the current ones; knights and kings
+// only attack the next columns, so the rows of the last two pieces are enough for them
+func (c *queenCounter) place(col int, taken uint64, ascending uint64, descending uint64, previous uint64, beforePrevious uint64) {
+	if c.iterations >= maxCountingSteps {
+		return
+	}
+	c.iterations++
+	if col == c.n {
+		c.total++
+		if c.checkSymmetry {
+			c.countSymmetries()
+		}
+		return
+	}
+
+	available := c.allowed[col]
+	if c.piece.straight {
+		available &^= taken
+	}
+	if c.piece.diagonal {
+		available &^= ascending | descending
+	}
+	if c.piece.knight {
+		available &^= previous<<2 | previous>>2 | beforePrevious<<1 | beforePrevious>>1
+	}
+	if c.piece.king {
+		available &^= previous<<1 | previous | previous>>1
+	}
+
+	for available != 0 {
+		bit := available & -available
+		available ^= bit
+
+		c.rows[col] = bits.TrailingZeros64(bit)
+		c.place(col+1, taken|bit, (ascending|bit)<<1&c.full, (descending|bit)>>1, bit, previous)
+	}
+}
+
+// Checks whether the solution stays the same after a rotation by 180 degrees, and then by 90 degrees
+func (c *queenCounter) countSymmetries() {
+	n := c.n
+	for col, row := range c.rows {
+		if c.rows[n-1-col] != n-1-row {
+			return
+		}
+	}
+	c.rotation180++
+
+	for col, row := range c.rows {
+		if c.rows[n-1-row] != col {
+			return
+		}
+	}
+	c.rotation90++
+}
+
+// Lists the solutions in lexicographic order of their rows (column by column) with iterative backtracking,
+// starting from the position of the cursor and stopping once the page is full; when counting, the solutions are not kept
+// and the search goes on until every one of them was found
+func (s *NQueensSolver) listSolutions() {
+	board := s.currentChessboard
+	piece := board.piece
+	shape := board.shape
+	n := board.n
+	counting := s.mode == CountSolutionsMode
+
+	// the diagonals of a torus are told apart by the remainder of their squares modulo the greatest common divisor of the dimensions
+	diagonals := func(row int, col int) (int, int) {
+		if shape.torus {
+			period := gcd(shape.rows, shape.cols)
+			return modulo(row+col, period), modulo(row-col, period)
+		}
+		return row + col, row - col + n
+	}
+
+	taken := make([]bool, shape.rows)
+	ascending := make([]bool, shape.rows+n)
+	descending := make([]bool, shape.rows+n)
+	mark := func(row int, col int, value bool) {
+		up, down := diagonals(row, col)
+		taken[row] = value
+		ascending[up] = value
+		descending[down] = value
+	}
+
+	rows := slices.Clone(s.position)
+	col := len(rows) - 1
+	for placedCol, row := range rows[:col] {
+		mark(row, placedCol, true)
+	}
+
+	// the lines are checked in constant time, while the knights and kings only attack the last two columns,
+	// as well as the first two columns of a torus
+	free := func(row int, col int) bool {
+		if !board.queens[col].possibleValues[row] {
+			return false
+		}
+		up, down := diagonals(row, col)
+		if (piece.straight && taken[row]) || (piece.diagonal && (ascending[up] || descending[down])) {
+			return false
+		}
+		if piece.knight || piece.king {
+			neighbors := []int{col - 2, col - 1}
+			if shape.torus {
+				neighbors = append(neighbors, col+1-n, col+2-n)
+			}
+			for _, previous := range neighbors {
+				if previous < 0 || previous >= col {
+					continue
+				}
+				if piece.attacks(shape, rows[previous], previous, row, col) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	steps := maxListingSteps
+	if counting {
+		steps = maxTorusCountingSteps
+	}
+	s.solutions = make([][]int, 0)
+	s.position = nil
+
+	// the search would otherwise go through every placement before finding out that there are no solutions
+	if board.modularlyUnsolvable() {
+		return
+	}
+
+	for col >= 0 {
+		if (!counting && len(s.solutions) == s.limit) || s.iterations >= steps {
+			s.position = slices.Clone(rows)
+			s.exhausted = counting || len(s.solutions) < s.limit
+			break
+		}
+		s.iterations++
+
+		row := rows[col]
+		for row < shape.rows && !free(row, col) {
+			row++
+		}
+
+		// backtracking to the previous column, whose piece is moved to the next row
+		if row == shape.rows {
+			rows = rows[:col]
+			col--
+			if col >= 0 {
+				mark(rows[col], col, false)
+				rows[col]++
+			}
+			continue
+		}
+
+		rows[col] = row
+		if col == n-1 {
+			if counting {
+				s.total++
+			} else {
+				s.solutions = append(s.solutions, slices.Clone(rows))
+			}
+			rows[col]++
+			continue
+		}
+
+		mark(row, col, true)
+		col++
+		rows = append(rows, 0)
+	}
+
+	s.solvable = len(s.solutions) > 0 || s.total > 0
+}
+
+func (s *NQueensSolver) formatCount(result NQueensResult) NQueensResult {
+	result.TotalSolutions = s.total
+	result.Message = fmt.Sprintf("Found %d solutions", s.total)
+	result.FormattedOutput = fmt.Sprintf("Total solutions: %d\n", s.total)
+	if s.exhausted {
+		steps := maxCountingSteps
+		if s.currentChessboard.shape.torus {
+			steps = maxTorusCountingSteps
+		}
+		result.Message = fmt.Sprintf("Found at least %d solutions, the search was stopped after %d steps", s.total, steps)
+		return result
+	}
+
+	if s.symmetric {
+		result.FundamentalSolutions = s.fundamental
+		result.Message += fmt.Sprintf(", of which %d are fundamental", s.fundamental)
+		result.FormattedOutput += fmt.Sprintf("Fundamental solutions: %d\n", s.fundamental)
+	}
+
+	return result
+}
+
+func (s *NQueensSolver) formatSolutions(result NQueensResult) NQueensResult {
+	result.Solutions = s.solutions
+	result.FormattedOutput = ""
+
+	switch {
+	case s.exhausted:
+		result.Message = fmt.Sprintf("Found %d solutions within %d steps, the search can be continued from the next cursor", len(s.solutions), maxListingSteps)
+	case s.position == nil && len(s.solutions) == 0:
+		result.Message = "No more solutions"
+	case s.position == nil:
+		result.Message = fmt.Sprintf("Found %d solutions, which are the last ones", len(s.solutions))
+	default:
+		result.Message = fmt.Sprintf("Found %d solutions", len(s.solutions))
+	}
+
+	if s.position != nil {
+		result.NextCursor = encodeCursor(s.position)
+	}
+
+	for index, rows := range s.solutions {
+		queens := make([]string, len(rows))
+		for col, row := range rows {
+			queens[col] = fmt.Sprintf("(%d, %d)", row, col)
+		}
+		result.FormattedOutput += fmt.Sprintf("Solution %d: %s\n", index+1, strings.Join(queens, " "))
+	}
+
+	return result
+}
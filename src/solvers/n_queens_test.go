@@ -1,8 +1,10 @@
 package solvers
 
 import (
+	"encoding/json"
 	"fmt"
 	"maps"
+	"strings"
 	"testing"
 )
 
@@ -46,7 +48,7 @@ func TestNQueens(t *testing.T) {
 
 	for testCount, test := range testCases {
 		solver := NQueensSolver{}
-		err := solver.Initialize(test.n, test.blocked)
+		err := solver.Initialize(test.n, test.blocked, NQueensOptions{})
 
 		// validating input data
 		if err != nil {
@@ -76,3 +78,492 @@ func TestNQueens(t *testing.T) {
 		fmt.Printf("%s\n", result.FormattedOutput)
 	}
 }
+
+func TestNQueensCount(t *testing.T) {
+	type testCase struct {
+		n                   int
+		blocked             [][]int
+		expectedTotal       int
+		expectedFundamental int
+	}
+
+	testCases := []testCase{
+		{n: 1, expectedTotal: 1, expectedFundamental: 1},
+		{n: 3, expectedTotal: 0, expectedFundamental: 0},
+		{n: 4, expectedTotal: 2, expectedFundamental: 1},
+		{n: 5, expectedTotal: 10, expectedFundamental: 2},
+		{n: 6, expectedTotal: 4, expectedFundamental: 1},
+		{n: 8, expectedTotal: 92, expectedFundamental: 12},
+		{n: 10, expectedTotal: 724, expectedFundamental: 92},
+		{n: 5, blocked: [][]int{{0, 0}, {1, 1}, {2, 2}, {3, 4}, {4, 4}}, expectedTotal: 2},
+	}
+
+	for testCount, test := range testCases {
+		solver := NQueensSolver{}
+		err := solver.Initialize(test.n, test.blocked, NQueensOptions{Mode: CountSolutionsMode})
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if result.TotalSolutions != test.expectedTotal {
+			t.Errorf("[Test %d] The number of solutions does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, result.TotalSolutions, test.expectedTotal)
+		}
+		if result.FundamentalSolutions != test.expectedFundamental {
+			t.Errorf("[Test %d] The number of fundamental solutions does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, result.FundamentalSolutions, test.expectedFundamental)
+		}
+
+		// validating that listing every solution page by page finds the same number of distinct solutions
+		listed := make(map[string]bool)
+		cursor := ""
+		for pages := 0; pages == 0 || cursor != ""; pages++ {
+			solver := NQueensSolver{}
+			err := solver.Initialize(test.n, test.blocked, NQueensOptions{Mode: AllSolutionsMode, Cursor: cursor, Limit: 3})
+			if err != nil {
+				t.Errorf("%s", err)
+				break
+			}
+
+			solver.Solve()
+			page := solver.FormatResult()
+			if len(page.Solutions) > 3 {
+				t.Errorf("[Test %d] The page has more solutions than the limit: %d.", testCount+1, len(page.Solutions))
+			}
+			for _, rows := range page.Solutions {
+				listed[fmt.Sprint(rows)] = true
+			}
+			cursor = page.NextCursor
+		}
+
+		if len(listed) != test.expectedTotal {
+			t.Errorf("[Test %d] The number of listed solutions does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, len(listed), test.expectedTotal)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that cursors which do not describe a position of the board are rejected
+	solver := NQueensSolver{}
+	err := solver.Initialize(4, nil, NQueensOptions{Mode: AllSolutionsMode, Cursor: encodeCursor([]int{0, 1, 0})})
+	if err == nil {
+		t.Errorf("Expected a cursor with attacking queens to be rejected.")
+	}
+
+	// validating that square tori divisible by 2 or 3 are found unsolvable without searching them
+	err = solver.Initialize(12, nil, NQueensOptions{Mode: CountSolutionsMode, Topology: TorusTopology})
+	if err != nil {
+		t.Errorf("%s", err)
+	} else {
+		solver.Solve()
+		result := solver.FormatResult()
+		if result.TotalSolutions != 0 || solver.exhausted || solver.iterations != 0 {
+			t.Errorf("Expected no solutions on a 12x12 torus without any search, got %d solutions after %d iterations.", result.TotalSolutions, solver.iterations)
+		}
+	}
+
+	// validating that unsolvable boards still report the fields of their mode
+	for mode, field := range map[string]string{FirstSolutionMode: `"solution":[]`, CountSolutionsMode: `"total_solutions":0`} {
+		err = solver.Initialize(3, nil, NQueensOptions{Mode: mode})
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		output, _ := json.Marshal(solver.FormatResult())
+		if !strings.Contains(string(output), field) {
+			t.Errorf("Expected the result of the \"%s\" mode to contain %s, got %s.", mode, field, output)
+		}
+	}
+}
+
+func TestNQueensMinConflicts(t *testing.T) {
+	type testCase struct {
+		n                int
+		blocked          [][]int
+		expectedSolvable bool
+	}
+
+	testCases := []testCase{
+		{n: 3, expectedSolvable: false},
+		{n: 4, blocked: [][]int{{0, 1}, {1, 1}, {2, 1}, {3, 1}}, expectedSolvable: false},
+		{n: 8, blocked: [][]int{{0, 0}, {1, 0}, {2, 3}}, expectedSolvable: true},
+		{n: 1000, blocked: [][]int{{0, 0}, {500, 500}, {999, 999}}, expectedSolvable: true},
+		{n: 20000, expectedSolvable: true},
+	}
+
+	for testCount, test := range testCases {
+		solver := NQueensSolver{}
+		err := solver.Initialize(test.n, test.blocked, NQueensOptions{Strategy: MinConflictsStrategy})
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if solver.solvable != test.expectedSolvable {
+			t.Errorf("[Test %d] The solvability does not match the one expected.\nActual: %v\nExpected: %v", testCount+1, solver.solvable, test.expectedSolvable)
+		}
+
+		// validating that no queen is on a blocked square and that no two queens attack each other
+		blocked := make(map[[2]int]bool)
+		for _, pair := range test.blocked {
+			blocked[[2]int{pair[0], pair[1]}] = true
+		}
+		rows, ascending, descending := make(map[int]bool), make(map[int]bool), make(map[int]bool)
+		for _, queen := range result.Solution {
+			if blocked[[2]int{queen.Row, queen.Col}] {
+				t.Errorf("[Test %d] Queen %d is placed on the blocked square (%d, %d).", testCount+1, queen.Col, queen.Row, queen.Col)
+			}
+			if rows[queen.Row] || ascending[queen.Row+queen.Col] || descending[queen.Row-queen.Col] {
+				t.Errorf("[Test %d] Queen %d is attacked by another queen.", testCount+1, queen.Col)
+			}
+			rows[queen.Row], ascending[queen.Row+queen.Col], descending[queen.Row-queen.Col] = true, true, true
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.Message)
+	}
+}
+
+func TestNQueensFixed(t *testing.T) {
+	type testCase struct {
+		n             int
+		fixed         [][]int
+		options       NQueensOptions
+		expectedTotal int
+	}
+
+	testCases := []testCase{
+		{n: 8, fixed: [][]int{{0, 0}}, options: NQueensOptions{}},
+		{n: 8, fixed: [][]int{{3, 0}, {0, 5}}, options: NQueensOptions{}},
+		{n: 8, fixed: [][]int{{0, 0}}, options: NQueensOptions{Mode: CountSolutionsMode}, expectedTotal: 4},
+		{n: 1000, fixed: [][]int{{10, 20}, {500, 3}, {999, 998}}, options: NQueensOptions{Strategy: MinConflictsStrategy}},
+	}
+
+	for testCount, test := range testCases {
+		test.options.Fixed = test.fixed
+		solver := NQueensSolver{}
+		err := solver.Initialize(test.n, nil, test.options)
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if test.options.Mode == CountSolutionsMode {
+			if result.TotalSolutions != test.expectedTotal {
+				t.Errorf("[Test %d] The number of solutions does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, result.TotalSolutions, test.expectedTotal)
+			}
+			continue
+		}
+
+		// validating that the fixed queens kept their squares and were marked as given
+		placed := make(map[[2]int]bool)
+		for _, queen := range result.Solution {
+			placed[[2]int{queen.Row, queen.Col}] = queen.Given
+		}
+		if len(result.Solution) != test.n {
+			t.Errorf("[Test %d] The number of queens does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, len(result.Solution), test.n)
+		}
+		for _, pair := range test.fixed {
+			if given, exists := placed[[2]int{pair[0], pair[1]}]; !exists || !given {
+				t.Errorf("[Test %d] The fixed queen (%d, %d) is missing or not marked as given.", testCount+1, pair[0], pair[1])
+			}
+		}
+
+		// print solution to help with debugging
+		if test.n <= 8 {
+			fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+			fmt.Printf("%s\n", result.FormattedOutput)
+		}
+	}
+
+	// validating that the attacking pair of fixed queens is named in the error
+	solver := NQueensSolver{}
+	err := solver.Initialize(8, nil, NQueensOptions{Fixed: [][]int{{0, 0}, {5, 1}, {3, 3}}})
+	expected := "Fixed queens (0, 0) and (3, 3) attack each other, since they are on the same diagonal."
+	if err == nil || err.Error() != expected {
+		t.Errorf("The error does not match the one expected.\nActual: %v\nExpected: %s", err, expected)
+	}
+
+	err = solver.Initialize(8, [][]int{{2, 2}}, NQueensOptions{Fixed: [][]int{{2, 2}}})
+	if err == nil {
+		t.Errorf("Expected a fixed queen on a blocked square to be rejected.")
+	}
+}
+
+func TestNQueensPieces(t *testing.T) {
+	type testCase struct {
+		n             int
+		blocked       [][]int
+		fixed         [][]int
+		options       NQueensOptions
+		expectedTotal int
+	}
+
+	testCases := []testCase{
+		{n: 4, options: NQueensOptions{Piece: RookPiece, Mode: CountSolutionsMode}, expectedTotal: 24},
+		{n: 2, options: NQueensOptions{Piece: BishopPiece, Mode: CountSolutionsMode}, expectedTotal: 2},
+		{n: 3, options: NQueensOptions{Piece: KingPiece, Mode: CountSolutionsMode}, expectedTotal: 2},
+		{n: 8, options: NQueensOptions{Piece: AmazonPiece}, expectedTotal: 0},
+		{n: 10, options: NQueensOptions{Piece: AmazonPiece}, expectedTotal: 10},
+		{n: 8, options: NQueensOptions{Mode: MaximumPlacementMode}, expectedTotal: 8},
+		{n: 8, options: NQueensOptions{Piece: BishopPiece, Mode: MaximumPlacementMode}, expectedTotal: 14},
+		{n: 8, options: NQueensOptions{Piece: KnightPiece, Mode: MaximumPlacementMode}, expectedTotal: 32},
+		{n: 8, options: NQueensOptions{Piece: KingPiece, Mode: MaximumPlacementMode}, expectedTotal: 16},
+		{n: 3, options: NQueensOptions{Mode: MaximumPlacementMode}, expectedTotal: 2},
+		{n: 6, blocked: [][]int{{0, 0}, {2, 3}}, fixed: [][]int{{0, 1}, {1, 1}}, options: NQueensOptions{Piece: BishopPiece, Mode: MaximumPlacementMode}, expectedTotal: 9},
+		{n: 14, options: NQueensOptions{Mode: MaximumPlacementMode}, expectedTotal: 14},
+		{n: 15, options: NQueensOptions{Mode: MaximumPlacementMode}, expectedTotal: 15},
+		{n: 10, options: NQueensOptions{Piece: BishopPiece, Mode: MaximumPlacementMode}, expectedTotal: 18},
+		{n: 9, options: NQueensOptions{Piece: KnightPiece, Mode: MaximumPlacementMode}, expectedTotal: 41},
+		{n: 9, options: NQueensOptions{Piece: KingPiece, Mode: MaximumPlacementMode}, expectedTotal: 25},
+	}
+
+	for testCount, test := range testCases {
+		test.options.Fixed = test.fixed
+		solver := NQueensSolver{}
+		err := solver.Initialize(test.n, test.blocked, test.options)
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if test.options.Mode == CountSolutionsMode {
+			if result.TotalSolutions != test.expectedTotal {
+				t.Errorf("[Test %d] The number of solutions does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, result.TotalSolutions, test.expectedTotal)
+			}
+			continue
+		}
+
+		if len(result.Solution) != test.expectedTotal {
+			t.Errorf("[Test %d] The number of pieces does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, len(result.Solution), test.expectedTotal)
+		}
+
+		// validating that no two pieces attack each other and that the fixed ones kept their squares
+		piece, shape := solver.currentChessboard.piece, solver.currentChessboard.shape
+		placed := make(map[[2]int]bool)
+		for index, queen := range result.Solution {
+			placed[[2]int{queen.Row, queen.Col}] = queen.Given
+			for _, other := range result.Solution[:index] {
+				if piece.attacks(shape, queen.Row, queen.Col, other.Row, other.Col) {
+					t.Errorf("[Test %d] The pieces (%d, %d) and (%d, %d) attack each other.", testCount+1, queen.Row, queen.Col, other.Row, other.Col)
+				}
+			}
+		}
+		for _, pair := range test.fixed {
+			if given, exists := placed[[2]int{pair[0], pair[1]}]; !exists || !given {
+				t.Errorf("[Test %d] The fixed piece (%d, %d) is missing or not marked as given.", testCount+1, pair[0], pair[1])
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that fixed knights a knight's move apart are rejected
+	solver := NQueensSolver{}
+	err := solver.Initialize(8, nil, NQueensOptions{Piece: KnightPiece, Fixed: [][]int{{0, 0}, {2, 1}}})
+	expected := "Fixed knights (0, 0) and (2, 1) attack each other, since they are a knight's move apart."
+	if err == nil || err.Error() != expected {
+		t.Errorf("The error does not match the one expected.\nActual: %v\nExpected: %s", err, expected)
+	}
+
+	err = solver.Initialize(8, nil, NQueensOptions{Piece: "pawn"})
+	if err == nil {
+		t.Errorf("Expected an unknown piece to be rejected.")
+	}
+}
+
+func TestNQueensBoards(t *testing.T) {
+	type testCase struct {
+		blocked       [][]int
+		options       NQueensOptions
+		expectedTotal int
+	}
+
+	testCases := []testCase{
+		{options: NQueensOptions{Rows: 4, Cols: 5, Mode: CountSolutionsMode}, expectedTotal: 12},
+		{options: NQueensOptions{Rows: 5, Cols: 4, Mode: CountSolutionsMode}, expectedTotal: 12},
+		{options: NQueensOptions{Rows: 4, Cols: 6, Mode: CountSolutionsMode}, expectedTotal: 46},
+		{options: NQueensOptions{Rows: 5, Cols: 5, Topology: TorusTopology, Mode: CountSolutionsMode}, expectedTotal: 10},
+		{options: NQueensOptions{Rows: 7, Cols: 7, Topology: TorusTopology, Mode: CountSolutionsMode}, expectedTotal: 28},
+		{options: NQueensOptions{Rows: 5, Cols: 7, Topology: TorusTopology, Mode: CountSolutionsMode}, expectedTotal: 0},
+		{options: NQueensOptions{Rows: 4, Cols: 4, Piece: KnightPiece, Topology: TorusTopology, Mode: CountSolutionsMode}, expectedTotal: 36},
+		{options: NQueensOptions{Rows: 6, Cols: 6, Piece: KingPiece, Topology: TorusTopology, Mode: CountSolutionsMode}, expectedTotal: 858},
+		{blocked: [][]int{{0, 0}, {2, 5}}, options: NQueensOptions{Rows: 4, Cols: 9}, expectedTotal: 4},
+		{options: NQueensOptions{Rows: 13, Cols: 13, Topology: TorusTopology}, expectedTotal: 13},
+		{options: NQueensOptions{Rows: 8, Cols: 8, Topology: TorusTopology}, expectedTotal: 0},
+		{options: NQueensOptions{Rows: 4, Cols: 6, Piece: KnightPiece, Topology: TorusTopology, Mode: MaximumPlacementMode}, expectedTotal: 12},
+		{options: NQueensOptions{Rows: 1001, Cols: 1001, Topology: TorusTopology, Strategy: MinConflictsStrategy, Fixed: [][]int{{3, 5}, {18, 10}}}, expectedTotal: 1001},
+	}
+
+	for testCount, test := range testCases {
+		solver := NQueensSolver{}
+		err := solver.Initialize(0, test.blocked, test.options)
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if test.options.Mode == CountSolutionsMode {
+			if result.TotalSolutions != test.expectedTotal {
+				t.Errorf("[Test %d] The number of solutions does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, result.TotalSolutions, test.expectedTotal)
+			}
+			continue
+		}
+
+		if len(result.Solution) != test.expectedTotal {
+			t.Errorf("[Test %d] The number of pieces does not match the one expected.\nActual: %d\nExpected: %d", testCount+1, len(result.Solution), test.expectedTotal)
+		}
+
+		// validating that the pieces are on the board as it was given and that no two of them attack each other
+		shape := boardShape{rows: test.options.Rows, cols: test.options.Cols, torus: test.options.Topology == TorusTopology}
+		piece := solver.currentChessboard.piece
+		for index, queen := range result.Solution {
+			if !shape.contains(queen.Row, queen.Col) {
+				t.Errorf("[Test %d] The piece (%d, %d) is outside of the board.", testCount+1, queen.Row, queen.Col)
+			}
+			for _, other := range result.Solution[:index] {
+				if piece.attacks(shape, queen.Row, queen.Col, other.Row, other.Col) {
+					t.Errorf("[Test %d] The pieces (%d, %d) and (%d, %d) attack each other.", testCount+1, queen.Row, queen.Col, other.Row, other.Col)
+				}
+			}
+		}
+
+		// print solution to help with debugging
+		if len(result.Solution) <= 16 {
+			fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+			fmt.Printf("%s\n", result.FormattedOutput)
+		}
+	}
+
+	// validating that the fixed pieces of a transposed board cannot share a row, and that the diagonals of a torus wrap around
+	solver := NQueensSolver{}
+	err := solver.Initialize(0, nil, NQueensOptions{Rows: 3, Cols: 5, Piece: BishopPiece, Fixed: [][]int{{1, 0}, {1, 3}}})
+	expected := "Fixed bishops (1, 0) and (1, 3) are on the same row, but every row holds exactly one piece."
+	if err == nil || err.Error() != expected {
+		t.Errorf("The error does not match the one expected.\nActual: %v\nExpected: %s", err, expected)
+	}
+
+	err = solver.Initialize(5, nil, NQueensOptions{Topology: TorusTopology, Fixed: [][]int{{0, 1}, {4, 0}}})
+	expected = "Fixed queens (0, 1) and (4, 0) attack each other, since they are on the same diagonal."
+	if err == nil || err.Error() != expected {
+		t.Errorf("The error does not match the one expected.\nActual: %v\nExpected: %s", err, expected)
+	}
+
+	err = solver.Initialize(8, nil, NQueensOptions{Topology: "sphere"})
+	if err == nil {
+		t.Errorf("Expected an unknown topology to be rejected.")
+	}
+}
+
+func TestNQueensTrace(t *testing.T) {
+	type testCase struct {
+		n       int
+		blocked [][]int
+		options NQueensOptions
+	}
+
+	testCases := []testCase{
+		{n: 4, options: NQueensOptions{Trace: true}},
+		{n: 6, blocked: [][]int{{0, 0}, {2, 3}}, options: NQueensOptions{Trace: true, Fixed: [][]int{{1, 0}}}},
+		{n: 8, options: NQueensOptions{Trace: true, Piece: KnightPiece}},
+		{n: 0, options: NQueensOptions{Trace: true, Rows: 4, Cols: 7}},
+	}
+
+	for testCount, test := range testCases {
+		solver := NQueensSolver{}
+		err := solver.Initialize(test.n, test.blocked, test.options)
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if result.TraceTruncated {
+			t.Errorf("[Test %d] The trace was truncated.", testCount+1)
+		}
+
+		// validating that replaying the trace places the pieces of the solution, and that only pruned squares are restored
+		placed := make(map[[2]int]bool)
+		pruned := make(map[[2]int]int)
+		for index, event := range result.Trace {
+			switch event.Type {
+			case selectEvent:
+				if len(event.Domain) == 0 {
+					t.Errorf("[Test %d] Event %d selects a piece without possible values.", testCount+1, index)
+				}
+			case assignEvent:
+				placed[[2]int{event.Square[0], event.Square[1]}] = true
+			case pruneEvent:
+				for _, square := range event.Pruned {
+					pruned[[2]int{square[0], square[1]}]++
+				}
+			case backtrackEvent:
+				delete(placed, [2]int{event.Square[0], event.Square[1]})
+				for _, square := range event.Pruned {
+					pruned[[2]int{square[0], square[1]}]++
+				}
+				for _, square := range event.Restored {
+					if pruned[[2]int{square[0], square[1]}] == 0 {
+						t.Errorf("[Test %d] Event %d restores the square (%d, %d), which was not pruned.", testCount+1, index, square[0], square[1])
+					}
+					pruned[[2]int{square[0], square[1]}]--
+				}
+			}
+		}
+
+		expected := make(map[[2]int]bool)
+		for _, queen := range result.Solution {
+			if !queen.Given {
+				expected[[2]int{queen.Row, queen.Col}] = true
+			}
+		}
+		if !maps.Equal(placed, expected) {
+			t.Errorf("[Test %d] The pieces placed by the trace do not match the solution.\nActual: %v\nExpected: %v", testCount+1, placed, expected)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\nEvents: %d\n\n", result.FormattedOutput, len(result.Trace))
+	}
+
+	// validating that the trace stops at its limit and is only available for forward checking
+	solver := NQueensSolver{}
+	err := solver.Initialize(8, nil, NQueensOptions{Trace: true, TraceLimit: 5})
+	if err != nil {
+		t.Errorf("%s", err)
+	} else {
+		solver.Solve()
+		result := solver.FormatResult()
+		if len(result.Trace) != 5 || !result.TraceTruncated {
+			t.Errorf("Expected the trace to be truncated after 5 events, got %d events.", len(result.Trace))
+		}
+	}
+
+	err = solver.Initialize(8, nil, NQueensOptions{Trace: true, Mode: CountSolutionsMode})
+	if err == nil {
+		t.Errorf("Expected the trace to be rejected in the \"%s\" mode.", CountSolutionsMode)
+	}
+}
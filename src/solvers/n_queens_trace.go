@@ -0,0 +1,88 @@
+package solvers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Limits the number of events recorded while tracing the search
+const (
+	defaultTraceLimit = 1000
+	maxTraceLimit     = 100000
+)
+
+func (s *NQueensSolver) initializeTrace(options NQueensOptions) error {
+	if !options.Trace {
+		if options.TraceLimit != 0 {
+			return fmt.Errorf("Trace limit can only be used when the trace is requested.")
+		}
+		return nil
+	}
+	if (options.Mode != "" && options.Mode != FirstSolutionMode) || s.strategy != ForwardCheckingAlgorithm {
+		return fmt.Errorf("The search can only be traced with the \"%s\" strategy in the \"%s\" mode.", ForwardCheckingAlgorithm, FirstSolutionMode)
+	}
+
+	limit := options.TraceLimit
+	if limit == 0 {
+		limit = defaultTraceLimit
+	}
+	if limit < 0 || limit > maxTraceLimit {
+		return fmt.Errorf("Trace limit must belong to the interval [1, %d], got %d.", maxTraceLimit, limit)
+	}
+
+	s.trace = &cspTrace{events: make([]cspEvent, 0), limit: limit}
+	return nil
+}
+
+// Describes the events of the search as changes of the board: the squares of the placed and removed pieces,
+// as well as the squares that were pruned from the domains of the pieces or restored to them
+func (s *NQueensSolver) formatTrace(result NQueensResult) NQueensResult {
+	board := s.currentChessboard
+	result.Trace = make([]NQueensTraceEvent, 0, len(s.trace.events))
+	result.TraceTruncated = s.trace.truncated
+
+	for _, event := range s.trace.events {
+		traced := NQueensTraceEvent{Type: event.kind, Queen: event.variable}
+
+		switch event.kind {
+		case selectEvent:
+			traced.Domain = event.domain
+		case assignEvent, pruneEvent, backtrackEvent:
+			row, col := board.square(event.value, event.variable)
+			traced.Square = []int{row, col}
+		}
+
+		squares := make([][]int, 0)
+		for _, other := range slices.Sorted(maps.Keys(event.changes)) {
+			for _, value := range event.changes[other] {
+				row, col := board.square(value, other)
+				squares = append(squares, []int{row, col})
+			}
+		}
+		switch event.kind {
+		case pruneEvent:
+			traced.Pruned = squares
+		case backtrackEvent:
+			traced.Restored = squares
+			if event.excluded {
+				traced.Pruned = [][]int{traced.Square}
+			}
+		}
+
+		result.Trace = append(result.Trace, traced)
+	}
+
+	return result
+}
+
+// Represents a step of the search; the domain lists the rows of the piece when it is selected
+// (or its columns, when the board was transposed), while the square is the one it is placed on or removed from
+type NQueensTraceEvent struct {
+	Type     string  `json:"type"`
+	Queen    int     `json:"queen"`
+	Square   []int   `json:"square,omitempty"`
+	Domain   []int   `json:"domain,omitempty"`
+	Pruned   [][]int `json:"pruned,omitempty"`
+	Restored [][]int `json:"restored,omitempty"`
+}
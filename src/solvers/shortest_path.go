@@ -4,161 +4,586 @@ import (
 	"container/heap"
 	"fmt"
 	"math"
+	"math/big"
+	"slices"
+	"strings"
 
 	"github.com/vanessahoamea/algorithms-api/src/utils"
 )
 
-// Represents an edge pointing to a given node, having a specified weight
-type edge struct {
-	node   int
-	weight int
+// Algorithms that can be selected when solving a Shortest Path problem instance
+const (
+	DijkstraAlgorithm              = "dijkstra"
+	BidirectionalDijkstraAlgorithm = "bidirectional-dijkstra"
+	BellmanFordAlgorithm           = "bellman-ford"
+)
+
+// Number representations that can be selected for the distances of a Shortest Path problem instance
+const (
+	FloatPrecision = "float"
+	BigPrecision   = "big"
+)
+
+// Modes that can be selected for edges with multiple weights, which either find every Pareto-optimal path
+// or the cheapest path (by the first weight) whose other weights stay within the given limits
+const (
+	ParetoMode      = "pareto"
+	ConstrainedMode = "constrained"
+)
+
+// The largest number of partial paths that a multi-criteria search can explore
+const maxCriteriaLabels = 1000000
+
+// Holds the optional settings of a Shortest Path problem instance
+type ShortestPathOptions struct {
+	Algorithm  string
+	Target     *NodeID
+	K          int
+	Undirected bool
+	Precision  string
+	Mode       string
+	Limits     []float64
 }
 
-// Represents a directed, weighted graph with no negative weights
-type graph struct {
-	n             int
-	adjacencyList map[int][]edge
+// Represents a path between two nodes, along with its total weight; paths found by a multi-criteria search
+// also keep the total of every weight and the edges they took
+type weightedPath struct {
+	nodes   []int
+	weight  float64
+	weights []float64
+	edges   []edge
 }
 
-func (g *graph) initialize(n int, edges [][3]int) error {
-	g.n = n
-	g.adjacencyList = make(map[int][]edge, n)
+// Represents a partial path explored by a multi-criteria search, which reaches a node with the given total weights
+// after extending the partial path found at the previous index
+type criteriaLabel struct {
+	node     int
+	weights  []float64
+	previous int
+	edge     edge
+}
 
-	for i := range n {
-		g.adjacencyList[i] = make([]edge, 0, n-1)
+// Handles the problem solving logic
+type ShortestPathSolver struct {
+	graph     graph
+	source    int
+	target    int
+	algorithm string
+	tree      shortestPathTree
+	settled   int
+	k         int
+	paths     []weightedPath
+	precision string
+	exact     []*big.Rat
+	mode      string
+	limits    []float64
+}
+
+func (s *ShortestPathSolver) Initialize(n int, edges []GraphEdge, source NodeID, options ShortestPathOptions) error {
+	s.algorithm = options.Algorithm
+	if s.algorithm == "" {
+		s.algorithm = DijkstraAlgorithm
+	}
+	if s.algorithm != DijkstraAlgorithm && s.algorithm != BidirectionalDijkstraAlgorithm && s.algorithm != BellmanFordAlgorithm {
+		return fmt.Errorf("Unknown algorithm \"%s\". Supported algorithms are \"%s\", \"%s\" and \"%s\".", s.algorithm, DijkstraAlgorithm, BidirectionalDijkstraAlgorithm, BellmanFordAlgorithm)
 	}
 
-	for _, group := range edges {
-		if group[0] < 0 || group[0] >= n {
-			return fmt.Errorf("Node %d in edge [%d, %d] (weight %d) is out of bounds. Node values belong to the interval [0, %d).", group[0], group[0], group[1], group[2], n)
-		}
+	s.precision = options.Precision
+	if s.precision == "" {
+		s.precision = FloatPrecision
+	}
+	if s.precision != FloatPrecision && s.precision != BigPrecision {
+		return fmt.Errorf("Unknown precision \"%s\". Supported precisions are \"%s\" and \"%s\".", s.precision, FloatPrecision, BigPrecision)
+	}
+	if s.precision == BigPrecision && (s.algorithm != DijkstraAlgorithm || options.K > 0) {
+		return fmt.Errorf("The \"%s\" precision is only supported by the \"%s\" algorithm, without multiple paths.", BigPrecision, DijkstraAlgorithm)
+	}
+
+	s.graph = graph{}
+	err := s.graph.initialize(n, edges, graphOptions{
+		directed:      !options.Undirected,
+		allowNegative: s.algorithm == BellmanFordAlgorithm,
+		exact:         s.precision == BigPrecision,
+	})
+	if err != nil {
+		return err
+	}
 
-		if group[1] < 0 || group[1] >= n {
-			return fmt.Errorf("Node %d in edge [%d, %d] (weight %d) is out of bounds. Node values belong to the interval [0, %d).", group[1], group[0], group[1], group[2], n)
+	s.source, err = s.graph.resolve(source)
+	if err != nil {
+		return fmt.Errorf("Invalid source node. %v", err)
+	}
+
+	s.target = -1
+	if options.Target != nil {
+		s.target, err = s.graph.resolve(*options.Target)
+		if err != nil {
+			return fmt.Errorf("Invalid target node. %v", err)
 		}
+	} else if s.algorithm == BidirectionalDijkstraAlgorithm {
+		return fmt.Errorf("The \"%s\" algorithm requires a target node.", BidirectionalDijkstraAlgorithm)
+	}
 
-		if group[2] < 0 {
-			return fmt.Errorf("Edge [%d, %d] has a negative weight: %d.", group[1], group[0], group[2])
+	s.k = options.K
+	if s.k < 0 {
+		return fmt.Errorf("Number of paths must be positive, got %d.", s.k)
+	}
+	if s.k > 0 {
+		if s.target == -1 {
+			return fmt.Errorf("Finding the %d shortest paths requires a target node.", s.k)
+		}
+		if s.algorithm != DijkstraAlgorithm {
+			return fmt.Errorf("Finding the %d shortest paths is only supported by the \"%s\" algorithm.", s.k, DijkstraAlgorithm)
 		}
+	}
 
-		edge := edge{node: group[1], weight: group[2]}
-		g.adjacencyList[group[0]] = append(g.adjacencyList[group[0]], edge)
+	s.mode = options.Mode
+	s.limits = options.Limits
+	if s.mode != "" {
+		err = s.initializeMode()
+		if err != nil {
+			return err
+		}
 	}
 
+	s.tree = newShortestPathTree(s.graph.n, s.source)
+	s.settled = 0
+	s.paths = make([]weightedPath, 0, s.k)
+	s.exact = nil
+
 	return nil
 }
 
-// Handles the problem solving logic
-type ShortestPathSolver struct {
-	graph     graph
-	source    int
-	distances []int
-	previous  []int
-	heap      utils.PriorityQueue[int]
+// Validates the settings of a multi-criteria search
+func (s *ShortestPathSolver) initializeMode() error {
+	if s.mode != ParetoMode && s.mode != ConstrainedMode {
+		return fmt.Errorf("Unknown mode \"%s\". Supported modes are \"%s\" and \"%s\".", s.mode, ParetoMode, ConstrainedMode)
+	}
+	if s.target == -1 {
+		return fmt.Errorf("The \"%s\" mode requires a target node.", s.mode)
+	}
+	if s.algorithm != DijkstraAlgorithm || s.k > 0 || s.precision != FloatPrecision {
+		return fmt.Errorf("The \"%s\" mode is only supported by the \"%s\" algorithm, without multiple paths or the \"%s\" precision.", s.mode, DijkstraAlgorithm, BigPrecision)
+	}
+
+	// the first weight is the one being minimized, so limits only apply to the other ones
+	if s.mode == ConstrainedMode && len(s.limits) == 0 {
+		return fmt.Errorf("The \"%s\" mode requires limits for the weights following the first one.", ConstrainedMode)
+	}
+	if len(s.limits) > 0 && len(s.limits) != s.graph.criteria-1 {
+		return fmt.Errorf("Length of limits array (%d) does not match the number of weights following the first one (%d).", len(s.limits), s.graph.criteria-1)
+	}
+
+	return nil
 }
 
-func (s *ShortestPathSolver) Initialize(n int, edges [][3]int, source int) error {
-	s.graph = graph{}
-	err := s.graph.initialize(n, edges)
+func (s *ShortestPathSolver) Solve() error {
+	if s.mode != "" {
+		err := s.multiCriteria()
+		if err != nil {
+			return err
+		}
 
-	s.source = source
-	if source < 0 || source >= n {
-		err = fmt.Errorf("Source node %d is out of bounds. Node values belong to the interval [0, %d).", source, n)
+		return s.graph.checkOverflow()
 	}
 
-	s.distances = make([]int, n)
-	s.previous = make([]int, n)
-	for i := range n {
-		s.distances[i] = math.MaxInt
-		s.previous[i] = -1
+	switch s.algorithm {
+	case BellmanFordAlgorithm:
+		err := s.graph.bellmanFord(&s.tree)
+		if err != nil {
+			return err
+		}
+
+		// every reachable node is settled once the distances stop changing
+		for _, distance := range s.tree.distances {
+			if !math.IsInf(distance, 1) {
+				s.settled++
+			}
+		}
+	case BidirectionalDijkstraAlgorithm:
+		s.settled = s.graph.bidirectionalDijkstra(&s.tree, s.source, s.target)
+	default:
+		if s.precision == BigPrecision {
+			s.exact, s.settled = s.graph.exactDijkstra(&s.tree, s.source, s.target)
+			return nil
+		}
+
+		s.settled = s.graph.dijkstra(&s.tree, s.source, s.target, nil)
+		if s.k > 0 {
+			s.yen()
+		}
 	}
-	s.distances[source] = 0
 
-	s.heap = make(utils.PriorityQueue[int], 0, n)
-	heap.Init(&s.heap)
+	err := s.graph.checkOverflow()
+	if err != nil && s.algorithm == DijkstraAlgorithm && s.k == 0 {
+		return fmt.Errorf("%v Use the \"%s\" precision instead.", err, BigPrecision)
+	}
 
 	return err
 }
 
-func (s *ShortestPathSolver) Solve() {
-	s.dijkstra()
-}
+// Finds the k shortest loopless paths towards the target, by deviating from the previously found paths
+// at each of their nodes (the spur nodes) while forbidding the edges those paths already took
+func (s *ShortestPathSolver) yen() {
+	if math.IsInf(s.tree.distances[s.target], 1) {
+		return
+	}
+	s.paths = append(s.paths, weightedPath{nodes: s.tree.pathTo(s.target), weight: s.tree.distances[s.target]})
+
+	candidates := make(utils.PriorityQueue[weightedPath, float64], 0)
+	heap.Init(&candidates)
+	seen := map[string]bool{fmt.Sprint(s.paths[0].nodes): true}
+
+	for len(s.paths) < s.k {
+		lastPath := s.paths[len(s.paths)-1].nodes
+
+		for i := 0; i < len(lastPath)-1; i++ {
+			spurNode := lastPath[i]
+			rootPath := lastPath[:i+1]
+
+			restrictions := searchRestrictions{
+				nodes: make(map[int]bool, i),
+				edges: make(map[[2]int]bool),
+			}
+			for _, path := range s.paths {
+				if len(path.nodes) > i+1 && slices.Equal(path.nodes[:i+1], rootPath) {
+					restrictions.edges[[2]int{path.nodes[i], path.nodes[i+1]}] = true
+				}
+			}
+			for _, node := range rootPath[:i] {
+				restrictions.nodes[node] = true
+			}
+
+			spurTree := newShortestPathTree(s.graph.n, spurNode)
+			s.settled += s.graph.dijkstra(&spurTree, spurNode, s.target, &restrictions)
+			if math.IsInf(spurTree.distances[s.target], 1) {
+				continue
+			}
+
+			rootWeight := 0.0
+			for j := 1; j < len(rootPath); j++ {
+				rootWeight = s.graph.extend(rootWeight, s.graph.minWeight(rootPath[j-1], rootPath[j]))
+			}
 
-func (s *ShortestPathSolver) dijkstra() {
-	// initialize the heap with the source node, whose minimum distance is 0
-	item := utils.Item[int]{
-		Value:    s.source,
-		Priority: -s.distances[s.source],
+			candidate := weightedPath{
+				nodes:  append(slices.Clone(rootPath[:i]), spurTree.pathTo(s.target)...),
+				weight: s.graph.extend(rootWeight, spurTree.distances[s.target]),
+			}
+			key := fmt.Sprint(candidate.nodes)
+			if !seen[key] {
+				seen[key] = true
+				heap.Push(&candidates, &utils.Item[weightedPath, float64]{Value: candidate, Priority: -candidate.weight})
+			}
+		}
+
+		if candidates.Len() == 0 {
+			break
+		}
+		s.paths = append(s.paths, heap.Pop(&candidates).(*utils.Item[weightedPath, float64]).Value)
 	}
-	heap.Push(&s.heap, &item)
+}
+
+// Runs a multi-criteria version of Dijkstra's algorithm, where every node keeps the partial paths that are not
+// dominated by another one (no worse in every weight); labels are expanded in increasing order of their total weight
+// in "pareto" mode, which means that a label can never be dominated by one expanded after it, and by their first weight
+// in "constrained" mode, so that the first label reaching the target is the cheapest one within the limits
+func (s *ShortestPathSolver) multiCriteria() error {
+	labels := []criteriaLabel{{node: s.source, weights: make([]float64, s.graph.criteria), previous: -1}}
+	kept := make(map[int][]int, s.graph.n)
 
-	for s.heap.Len() > 0 {
-		current := heap.Pop(&s.heap).(*utils.Item[int])
-		node := current.Value
-		distance := current.Priority * -1
+	pq := make(utils.PriorityQueue[int, float64], 0)
+	heap.Init(&pq)
+	heap.Push(&pq, &utils.Item[int, float64]{Value: 0, Priority: 0})
 
-		// if the node has already been processed, skip it
-		if distance > s.distances[node] {
+	for pq.Len() > 0 {
+		index := heap.Pop(&pq).(*utils.Item[int, float64]).Value
+		label := labels[index]
+
+		// a label dominated at its own node or at the target can not lead to a better path
+		if s.dominated(labels, kept[label.node], label.weights) || s.dominated(labels, kept[s.target], label.weights) {
 			continue
 		}
+		kept[label.node] = append(kept[label.node], index)
+		s.settled++
 
-		// add all adjacent neighbors to the priority queue
-		for _, edge := range s.graph.adjacencyList[node] {
-			if s.distances[node]+edge.weight < s.distances[edge.node] {
-				s.distances[edge.node] = s.distances[node] + edge.weight
-				s.previous[edge.node] = node
-				newItem := utils.Item[int]{
-					Value:    edge.node,
-					Priority: -s.distances[edge.node],
-				}
-				heap.Push(&s.heap, &newItem)
+		if label.node == s.target {
+			if s.mode == ConstrainedMode {
+				break
 			}
+			continue
+		}
+
+		for _, edge := range s.graph.adjacencyList[label.node] {
+			weights := make([]float64, s.graph.criteria)
+			for i, weight := range edge.criteria() {
+				weights[i] = s.graph.extend(label.weights[i], weight)
+			}
+			if !s.withinLimits(weights) {
+				continue
+			}
+
+			if len(labels) == maxCriteriaLabels {
+				return fmt.Errorf("The search was stopped after exploring %d partial paths. Try setting stricter limits.", maxCriteriaLabels)
+			}
+			labels = append(labels, criteriaLabel{node: edge.node, weights: weights, previous: index, edge: edge})
+			heap.Push(&pq, &utils.Item[int, float64]{Value: len(labels) - 1, Priority: -s.labelPriority(weights)})
+		}
+	}
+
+	// rebuilding the paths that reached the target, from the cheapest to the most expensive
+	for _, index := range kept[s.target] {
+		path := weightedPath{weight: labels[index].weights[0], weights: labels[index].weights}
+		for current := index; current > -1; current = labels[current].previous {
+			path.nodes = append(path.nodes, labels[current].node)
+			if labels[current].previous > -1 {
+				path.edges = append(path.edges, labels[current].edge)
+			}
+		}
+		slices.Reverse(path.nodes)
+		slices.Reverse(path.edges)
+
+		s.paths = append(s.paths, path)
+	}
+	slices.SortStableFunc(s.paths, func(a weightedPath, b weightedPath) int {
+		return slices.Compare(a.weights, b.weights)
+	})
+
+	return nil
+}
+
+// Checks whether any of the kept labels is at least as good as the given weights in every criterion
+func (s *ShortestPathSolver) dominated(labels []criteriaLabel, kept []int, weights []float64) bool {
+	for _, index := range kept {
+		dominates := true
+		for i, weight := range labels[index].weights {
+			if weight > weights[i] {
+				dominates = false
+				break
+			}
+		}
+
+		if dominates {
+			return true
 		}
 	}
+
+	return false
+}
+
+func (s *ShortestPathSolver) withinLimits(weights []float64) bool {
+	for i, limit := range s.limits {
+		if weights[i+1] > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *ShortestPathSolver) labelPriority(weights []float64) float64 {
+	if s.mode == ConstrainedMode {
+		return weights[0]
+	}
+
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+
+	return total
 }
 
 func (s *ShortestPathSolver) FormatResult() ShortestPathResult {
 	result := ShortestPathResult{}
 
 	result.Message = "Solution found"
-	result.Solution = make([]ShortestPathResultNode, s.graph.n)
+	result.Solution = make([]ShortestPathResultNode, 0, s.graph.n)
+	result.SettledNodes = s.settled
 	result.FormattedOutput = ""
 
-	for i := range s.graph.n {
-		result.Solution[i] = ShortestPathResultNode{}
+	if s.mode != "" {
+		return s.formatMultiCriteria(result)
+	}
 
-		result.Solution[i].Node = i
-		result.Solution[i].Path = make([]int, 0, s.graph.n)
+	nodes := make([]int, 0, s.graph.n)
+	if s.target != -1 {
+		nodes = append(nodes, s.target)
+	} else {
+		for i := range s.graph.n {
+			nodes = append(nodes, i)
+		}
+	}
 
-		if s.distances[i] < math.MaxInt {
-			result.Solution[i].Distance = s.distances[i]
+	for _, node := range nodes {
+		path := s.tree.pathTo(node)
+		resultNode := ShortestPathResultNode{
+			Node:  s.graph.labels[node],
+			Path:  s.graph.labelPath(path),
+			Steps: s.steps(path, nil),
+		}
 
-			previousNode := i
-			for previousNode > -1 {
-				result.Solution[i].Path = append([]int{previousNode}, result.Solution[i].Path...)
-				previousNode = s.previous[previousNode]
+		resultNode.Reachable = !math.IsInf(s.tree.distances[node], 1)
+		if resultNode.Reachable {
+			resultNode.Distance = s.tree.distances[node]
+			distance := formatWeight(resultNode.Distance)
+			if s.exact != nil {
+				// the approximation is kept within range, so that it can always be serialized
+				resultNode.Distance = max(-math.MaxFloat64, min(math.MaxFloat64, resultNode.Distance))
+				resultNode.ExactDistance = formatExact(s.exact[node])
+				distance = resultNode.ExactDistance
 			}
 
-			result.FormattedOutput += fmt.Sprintf("Node %d: distance %d with path %v\n", result.Solution[i].Node, result.Solution[i].Distance, result.Solution[i].Path)
+			result.FormattedOutput += fmt.Sprintf("Node %s: distance %s with path %v\n", resultNode.Node, distance, resultNode.Path)
 		} else {
-			result.Solution[i].Distance = -1
+			resultNode.Distance = -1
 
-			result.FormattedOutput += fmt.Sprintf("Node %d: Not reachable from source\n", result.Solution[i].Node)
+			result.FormattedOutput += fmt.Sprintf("Node %s: Not reachable from source\n", resultNode.Node)
 		}
+
+		result.Solution = append(result.Solution, resultNode)
+	}
+
+	if s.k > 0 {
+		result.Paths = make([]ShortestPathResultPath, len(s.paths))
+		for i, path := range s.paths {
+			result.Paths[i] = ShortestPathResultPath{
+				Rank:     i + 1,
+				Distance: path.weight,
+				Path:     s.graph.labelPath(path.nodes),
+				Steps:    s.steps(path.nodes, nil),
+			}
+
+			result.FormattedOutput += fmt.Sprintf("Path #%d: distance %s with path %v\n", i+1, formatWeight(path.weight), result.Paths[i].Path)
+		}
+	}
+
+	if s.target != -1 {
+		if math.IsInf(s.tree.distances[s.target], 1) {
+			result.Message = "Target not reachable"
+		} else if s.k > 0 && len(s.paths) < s.k {
+			result.Message = fmt.Sprintf("Only %d loopless paths found", len(s.paths))
+		}
+		result.FormattedOutput += fmt.Sprintf("Settled nodes: %d\n", s.settled)
 	}
 
 	return result
 }
 
+// Fills in the result of a multi-criteria search, where the target gets the cheapest path that was found
+// and, in "pareto" mode, every Pareto-optimal path is listed along with the total of each of its weights
+func (s *ShortestPathSolver) formatMultiCriteria(result ShortestPathResult) ShortestPathResult {
+	resultNode := ShortestPathResultNode{
+		Node:     s.graph.labels[s.target],
+		Distance: -1,
+		Path:     make([]NodeID, 0),
+		Steps:    make([]ShortestPathResultStep, 0),
+	}
+
+	if len(s.paths) == 0 {
+		result.Message = "Target not reachable"
+		if len(s.limits) > 0 {
+			result.Message = "No path within the limits"
+		}
+
+		result.FormattedOutput += fmt.Sprintf("Node %s: %s\n", resultNode.Node, result.Message)
+	} else {
+		resultNode.Distance = s.paths[0].weight
+		resultNode.Reachable = true
+		resultNode.Weights = s.paths[0].weights
+		resultNode.Path = s.graph.labelPath(s.paths[0].nodes)
+		resultNode.Steps = s.steps(s.paths[0].nodes, s.paths[0].edges)
+
+		result.FormattedOutput += fmt.Sprintf("Node %s: weights %s with path %v\n", resultNode.Node, formatWeights(resultNode.Weights), resultNode.Path)
+	}
+	result.Solution = append(result.Solution, resultNode)
+
+	if s.mode == ParetoMode {
+		result.Paths = make([]ShortestPathResultPath, len(s.paths))
+		for i, path := range s.paths {
+			result.Paths[i] = ShortestPathResultPath{
+				Rank:     i + 1,
+				Distance: path.weight,
+				Weights:  path.weights,
+				Path:     s.graph.labelPath(path.nodes),
+				Steps:    s.steps(path.nodes, path.edges),
+			}
+
+			result.FormattedOutput += fmt.Sprintf("Pareto-optimal path #%d: weights %s with path %v\n", i+1, formatWeights(path.weights), result.Paths[i].Path)
+		}
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Settled labels: %d\n", s.settled)
+
+	return result
+}
+
+// Lists the edges along a path in the orientation they were travelled, marking the ones
+// that go against the orientation in which they were declared; if the edges that were taken are not known,
+// the cheapest edge between every two consecutive nodes is used
+func (s *ShortestPathSolver) steps(path []int, edges []edge) []ShortestPathResultStep {
+	steps := make([]ShortestPathResultStep, 0, len(path))
+
+	for i := 1; i < len(path); i++ {
+		var edge edge
+		if edges != nil {
+			edge = edges[i-1]
+		} else {
+			edge, _ = s.graph.cheapestEdge(path[i-1], path[i])
+		}
+
+		steps = append(steps, ShortestPathResultStep{
+			From:     s.graph.labels[path[i-1]],
+			To:       s.graph.labels[path[i]],
+			Weight:   edge.weight,
+			Weights:  edge.weights,
+			Reversed: edge.reversed,
+		})
+	}
+
+	return steps
+}
+
+// Formats a vector of weights as a list
+func formatWeights(weights []float64) string {
+	formatted := make([]string, len(weights))
+	for i, weight := range weights {
+		formatted[i] = formatWeight(weight)
+	}
+
+	return "[" + strings.Join(formatted, ", ") + "]"
+}
+
 // Represents the final solution obtained after running the algorithm
 type ShortestPathResult struct {
 	Message         string                   `json:"message"`
 	Solution        []ShortestPathResultNode `json:"solution"`
+	Paths           []ShortestPathResultPath `json:"paths,omitempty"`
+	SettledNodes    int                      `json:"settled_nodes"`
 	FormattedOutput string                   `json:"formatted_output"`
 }
 
 type ShortestPathResultNode struct {
-	Node     int   `json:"node"`
-	Distance int   `json:"distance"`
-	Path     []int `json:"path"`
+	Node          NodeID                   `json:"node"`
+	Distance      float64                  `json:"distance"`
+	Reachable     bool                     `json:"reachable"`
+	ExactDistance string                   `json:"exact_distance,omitempty"`
+	Weights       []float64                `json:"weights,omitempty"`
+	Path          []NodeID                 `json:"path"`
+	Steps         []ShortestPathResultStep `json:"steps"`
+}
+
+type ShortestPathResultPath struct {
+	Rank     int                      `json:"rank"`
+	Distance float64                  `json:"distance"`
+	Weights  []float64                `json:"weights,omitempty"`
+	Path     []NodeID                 `json:"path"`
+	Steps    []ShortestPathResultStep `json:"steps"`
+}
+
+type ShortestPathResultStep struct {
+	From     NodeID    `json:"from"`
+	To       NodeID    `json:"to"`
+	Weight   float64   `json:"weight"`
+	Weights  []float64 `json:"weights,omitempty"`
+	Reversed bool      `json:"reversed"`
 }
@@ -1,7 +1,12 @@
 package solvers
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -10,7 +15,7 @@ func TestShortestPath(t *testing.T) {
 		n                 int
 		edges             [][3]int
 		source            int
-		expectedDistances []int
+		expectedDistances []float64
 		expectedPaths     [][]int
 	}
 
@@ -25,7 +30,7 @@ func TestShortestPath(t *testing.T) {
 				{4, 3, 2}, {4, 5, 5},
 			},
 			source:            0,
-			expectedDistances: []int{0, 2, 3, 8, 6, 9},
+			expectedDistances: []float64{0, 2, 3, 8, 6, 9},
 			expectedPaths: [][]int{
 				{0},
 				{0, 1},
@@ -46,7 +51,7 @@ func TestShortestPath(t *testing.T) {
 				{5, 4, 3},
 			},
 			source:            0,
-			expectedDistances: []int{0, 45, 45, 10, 25, -1},
+			expectedDistances: []float64{0, 45, 45, 10, 25, -1},
 			expectedPaths: [][]int{
 				{0},
 				{0, 3, 4, 1},
@@ -60,7 +65,7 @@ func TestShortestPath(t *testing.T) {
 
 	for testCount, test := range testCases {
 		solver := ShortestPathSolver{}
-		err := solver.Initialize(test.n, test.edges, test.source)
+		err := solver.Initialize(test.n, toGraphEdges(test.edges), indexNode(test.source), ShortestPathOptions{})
 
 		// validating input data
 		if err != nil {
@@ -74,25 +79,403 @@ func TestShortestPath(t *testing.T) {
 			if expectedEdges[group[0]] == nil {
 				expectedEdges[group[0]] = make([]edge, 0, test.n-1)
 			}
-			expectedEdges[group[0]] = append(expectedEdges[group[0]], edge{node: group[1], weight: group[2]})
+			expectedEdges[group[0]] = append(expectedEdges[group[0]], edge{node: group[1], weight: float64(group[2])})
 		}
 
 		for i := range solver.graph.n {
-			if !validateArray(solver.graph.adjacencyList[i], expectedEdges[i]) {
+			if !validateEdges(solver.graph.adjacencyList[i], expectedEdges[i]) {
 				t.Errorf("[Node %d] The actual adjacency list does not match the one expected.\nActual %v\nExpected: %v", i, solver.graph.adjacencyList[i], expectedEdges[i])
 			}
 		}
 
 		// validating solution
-		solver.Solve()
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		for i, node := range result.Solution {
+			if node.Distance != test.expectedDistances[i] {
+				t.Errorf("[Node %s] The actual distance does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Distance, test.expectedDistances[i])
+			}
+			if !validateArray(node.Path, toNodeIDs(test.expectedPaths[i])) {
+				t.Errorf("[Node %s] The actual path does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Path, test.expectedPaths[i])
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestShortestPathBellmanFord(t *testing.T) {
+	type testCase struct {
+		n                 int
+		edges             [][3]int
+		source            int
+		expectedDistances []float64
+		expectedPaths     [][]int
+		expectedCycle     []int
+		expectedWeight    float64
+	}
+
+	testCases := []testCase{
+		{
+			n: 5,
+			edges: [][3]int{
+				{0, 1, 6}, {0, 2, 7},
+				{1, 2, 8}, {1, 3, 5}, {1, 4, -4},
+				{2, 3, -3}, {2, 4, 9},
+				{3, 1, -2},
+				{4, 0, 2}, {4, 3, 7},
+			},
+			source:            0,
+			expectedDistances: []float64{0, 2, 7, 4, -2},
+			expectedPaths: [][]int{
+				{0},
+				{0, 2, 3, 1},
+				{0, 2},
+				{0, 2, 3},
+				{0, 2, 3, 1, 4},
+			},
+		},
+		{
+			n: 5,
+			edges: [][3]int{
+				{0, 1, 1},
+				{1, 2, 2},
+				{2, 3, -4}, {2, 4, 1},
+				{3, 1, 1},
+			},
+			source:         0,
+			expectedCycle:  []int{1, 2, 3, 1},
+			expectedWeight: -1,
+		},
+		{
+			n: 4,
+			edges: [][3]int{
+				{0, 1, 3},
+				{2, 3, -5}, {3, 2, 1},
+			},
+			source:            0,
+			expectedDistances: []float64{0, 3, -1, -1},
+			expectedPaths: [][]int{
+				{0},
+				{0, 1},
+				{},
+				{},
+			},
+		},
+	}
+
+	for testCount, test := range testCases {
+		solver := ShortestPathSolver{}
+		err := solver.Initialize(test.n, toGraphEdges(test.edges), indexNode(test.source), ShortestPathOptions{Algorithm: BellmanFordAlgorithm})
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating negative cycle detection
+		err = solver.Solve()
+		if test.expectedCycle != nil {
+			cycleErr, ok := err.(*NegativeCycleError)
+			if !ok {
+				t.Errorf("Expected a negative cycle error, got: %v", err)
+				continue
+			}
+			if !validateCycle(cycleErr.Cycle, toNodeIDs(test.expectedCycle)) {
+				t.Errorf("The reported cycle does not match the one expected.\nActual: %v\nExpected: %v", cycleErr.Cycle, test.expectedCycle)
+			}
+			if cycleErr.Weight != test.expectedWeight {
+				t.Errorf("The reported cycle weight does not match the one expected.\nActual: %v\nExpected: %v", cycleErr.Weight, test.expectedWeight)
+			}
+
+			fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+			fmt.Printf("%s\n\n", err)
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		result := solver.FormatResult()
+
+		for i, node := range result.Solution {
+			if node.Distance != test.expectedDistances[i] {
+				t.Errorf("[Node %s] The actual distance does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Distance, test.expectedDistances[i])
+			}
+			if !validateArray(node.Path, toNodeIDs(test.expectedPaths[i])) {
+				t.Errorf("[Node %s] The actual path does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Path, test.expectedPaths[i])
+			}
+
+			// a distance of -1 is ambiguous with negative weights, so only the flag tells unreachable nodes apart
+			if node.Reachable != (len(test.expectedPaths[i]) > 0) {
+				t.Errorf("[Node %s] The reachability does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Reachable, len(test.expectedPaths[i]) > 0)
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestShortestPathTarget(t *testing.T) {
+	type testCase struct {
+		target           int
+		expectedDistance float64
+		expectedPath     []int
+		expectedSettled  int
+	}
+
+	n := 7
+	edges := [][3]int{
+		{0, 1, 2}, {0, 2, 4},
+		{1, 2, 1}, {1, 3, 7},
+		{2, 4, 3},
+		{3, 5, 1},
+		{4, 3, 2}, {4, 5, 5},
+		{6, 0, 1},
+	}
+	source := 0
+
+	testCases := []testCase{
+		{target: 0, expectedDistance: 0, expectedPath: []int{0}, expectedSettled: 1},
+		{target: 1, expectedDistance: 2, expectedPath: []int{0, 1}, expectedSettled: 2},
+		{target: 4, expectedDistance: 6, expectedPath: []int{0, 1, 2, 4}, expectedSettled: 4},
+		{target: 5, expectedDistance: 9, expectedPath: []int{0, 1, 2, 4, 3, 5}, expectedSettled: 6},
+		{target: 6, expectedDistance: -1, expectedPath: []int{}, expectedSettled: 6},
+	}
+
+	for testCount, test := range testCases {
+		target := indexNode(test.target)
+		for _, algorithm := range []string{DijkstraAlgorithm, BidirectionalDijkstraAlgorithm, BellmanFordAlgorithm} {
+			solver := ShortestPathSolver{}
+			err := solver.Initialize(n, toGraphEdges(edges), indexNode(source), ShortestPathOptions{Algorithm: algorithm, Target: &target})
+
+			// validating input data
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			// validating solution
+			err = solver.Solve()
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+			result := solver.FormatResult()
+
+			if len(result.Solution) != 1 {
+				t.Errorf("[%s, Node %d] Expected only the target node in the solution, got %d nodes.", algorithm, test.target, len(result.Solution))
+				continue
+			}
+			if result.Solution[0].Distance != test.expectedDistance {
+				t.Errorf("[%s, Node %d] The actual distance does not match the one expected.\nActual %v\nExpected: %v", algorithm, test.target, result.Solution[0].Distance, test.expectedDistance)
+			}
+			if !validateArray(result.Solution[0].Path, toNodeIDs(test.expectedPath)) {
+				t.Errorf("[%s, Node %d] The actual path does not match the one expected.\nActual %v\nExpected: %v", algorithm, test.target, result.Solution[0].Path, test.expectedPath)
+			}
+			if algorithm == DijkstraAlgorithm && result.SettledNodes != test.expectedSettled {
+				t.Errorf("[%s, Node %d] The number of settled nodes does not match the one expected.\nActual %d\nExpected: %d", algorithm, test.target, result.SettledNodes, test.expectedSettled)
+			}
+
+			// print solution to help with debugging
+			if algorithm == BidirectionalDijkstraAlgorithm {
+				fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+				fmt.Printf("%s\n", result.FormattedOutput)
+			}
+		}
+	}
+}
+
+func TestShortestPathKShortest(t *testing.T) {
+	type testCase struct {
+		k               int
+		target          int
+		expectedWeights []float64
+		expectedPaths   [][]int
+	}
+
+	n := 6
+	edges := [][3]int{
+		{0, 1, 3}, {0, 2, 2},
+		{1, 3, 4},
+		{2, 1, 1}, {2, 3, 2}, {2, 4, 3},
+		{3, 4, 2}, {3, 5, 1},
+		{4, 5, 2},
+	}
+	source := 0
+
+	testCases := []testCase{
+		{
+			k:               3,
+			target:          5,
+			expectedWeights: []float64{5, 7, 8},
+			expectedPaths: [][]int{
+				{0, 2, 3, 5},
+				{0, 2, 4, 5},
+			},
+		},
+		{
+			k:               10,
+			target:          3,
+			expectedWeights: []float64{4, 7, 7},
+			expectedPaths: [][]int{
+				{0, 2, 3},
+			},
+		},
+		{
+			k:               2,
+			target:          0,
+			expectedWeights: []float64{0},
+			expectedPaths: [][]int{
+				{0},
+			},
+		},
+	}
+
+	for testCount, test := range testCases {
+		target := indexNode(test.target)
+		solver := ShortestPathSolver{}
+		err := solver.Initialize(n, toGraphEdges(edges), indexNode(source), ShortestPathOptions{Target: &target, K: test.k})
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		if len(result.Paths) != len(test.expectedWeights) {
+			t.Errorf("[Node %d] The number of paths does not match the one expected.\nActual: %d\nExpected: %d", test.target, len(result.Paths), len(test.expectedWeights))
+			continue
+		}
+		for i, path := range result.Paths {
+			if path.Rank != i+1 {
+				t.Errorf("[Path %d] The rank does not match the one expected.\nActual: %d\nExpected: %d", i+1, path.Rank, i+1)
+			}
+			if path.Distance != test.expectedWeights[i] {
+				t.Errorf("[Path %d] The distance does not match the one expected.\nActual: %v\nExpected: %v", i+1, path.Distance, test.expectedWeights[i])
+			}
+			if i < len(test.expectedPaths) && !validateArray(path.Path, toNodeIDs(test.expectedPaths[i])) {
+				t.Errorf("[Path %d] The path does not match the one expected.\nActual: %v\nExpected: %v", i+1, path.Path, test.expectedPaths[i])
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestShortestPathUndirected(t *testing.T) {
+	type testCase struct {
+		edges             []GraphEdge
+		undirected        bool
+		expectedDistances []float64
+		expectedPaths     [][]int
+		expectedReversed  [][]bool
+	}
+
+	testCases := []testCase{
+		{
+			edges: []GraphEdge{
+				{From: indexNode(0), To: indexNode(1), Weight: 4},
+				{From: indexNode(2), To: indexNode(1), Weight: 1},
+				{From: indexNode(3), To: indexNode(0), Weight: 1, Direction: ForwardDirection},
+				{From: indexNode(3), To: indexNode(2), Weight: 1},
+			},
+			undirected:        true,
+			expectedDistances: []float64{0, 4, 5, 6},
+			expectedPaths: [][]int{
+				{0},
+				{0, 1},
+				{0, 1, 2},
+				{0, 1, 2, 3},
+			},
+			expectedReversed: [][]bool{
+				{},
+				{false},
+				{false, true},
+				{false, true, true},
+			},
+		},
+		{
+			edges: []GraphEdge{
+				{From: indexNode(0), To: indexNode(1), Weight: 1},
+				{From: indexNode(2), To: indexNode(1), Weight: 1, Direction: BackwardDirection},
+				{From: indexNode(2), To: indexNode(3), Weight: 1, Direction: BidirectionalDirection},
+				{From: indexNode(0), To: indexNode(3), Weight: 5},
+			},
+			undirected:        false,
+			expectedDistances: []float64{0, 1, 2, 3},
+			expectedPaths: [][]int{
+				{0},
+				{0, 1},
+				{0, 1, 2},
+				{0, 1, 2, 3},
+			},
+			expectedReversed: [][]bool{
+				{},
+				{false},
+				{false, true},
+				{false, true, false},
+			},
+		},
+	}
+
+	for testCount, test := range testCases {
+		solver := ShortestPathSolver{}
+		err := solver.Initialize(4, test.edges, indexNode(0), ShortestPathOptions{Undirected: test.undirected})
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
 		result := solver.FormatResult()
 
 		for i, node := range result.Solution {
 			if node.Distance != test.expectedDistances[i] {
-				t.Errorf("[Node %d] The actual distance does not match the one expected.\nActual %d\nExpected: %d", node.Node, node.Distance, test.expectedDistances[i])
+				t.Errorf("[Node %s] The actual distance does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Distance, test.expectedDistances[i])
+			}
+			if !validateArray(node.Path, toNodeIDs(test.expectedPaths[i])) {
+				t.Errorf("[Node %s] The actual path does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Path, test.expectedPaths[i])
 			}
-			if !validateArray(node.Path, test.expectedPaths[i]) {
-				t.Errorf("[Node %d] The actual path does not match the one expected.\nActual %v\nExpected: %v", node.Node, node.Path, test.expectedPaths[i])
+
+			reversed := make([]bool, len(node.Steps))
+			for j, step := range node.Steps {
+				reversed[j] = step.Reversed
+				if step.From != node.Path[j] || step.To != node.Path[j+1] {
+					t.Errorf("[Node %s] Step %d does not follow the path: [%s, %s].", node.Node, j, step.From, step.To)
+				}
+			}
+			if !validateArray(reversed, test.expectedReversed[i]) {
+				t.Errorf("[Node %s] The travelled orientation does not match the one expected.\nActual %v\nExpected: %v", node.Node, reversed, test.expectedReversed[i])
 			}
 		}
 
@@ -102,6 +485,404 @@ func TestShortestPath(t *testing.T) {
 	}
 }
 
+func TestGraphEdgeParsing(t *testing.T) {
+	var edges []GraphEdge
+	err := json.Unmarshal([]byte(`[[0, 1, 5], [2, 1, 3, "<->"]]`), &edges)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	expectedEdges := []GraphEdge{
+		{From: indexNode(0), To: indexNode(1), Weight: 5, literal: "5"},
+		{From: indexNode(2), To: indexNode(1), Weight: 3, Direction: BidirectionalDirection, literal: "3"},
+	}
+	if !validateEdges(edges, expectedEdges) {
+		t.Errorf("The parsed edges do not match the ones expected.\nActual: %v\nExpected: %v", edges, expectedEdges)
+	}
+
+	for _, invalid := range []string{`[[0, 1]]`, `[[0, 1, 2, "->", 3]]`, `[[0, 1, 2, 3]]`} {
+		err = json.Unmarshal([]byte(invalid), &edges)
+		if err == nil {
+			t.Errorf("Expected edges %s to be rejected.", invalid)
+		}
+	}
+}
+
+func TestShortestPathNamedNodes(t *testing.T) {
+	var edges []GraphEdge
+	err := json.Unmarshal([]byte(`[
+		["warehouse-A", "hub", 4], ["warehouse-A", "store-1", 9],
+		["hub", "store-1", 3], ["hub", "store-2", 7],
+		["store-2", "warehouse-B", 1]
+	]`), &edges)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	var source NodeID
+	err = json.Unmarshal([]byte(`"warehouse-A"`), &source)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	solver := ShortestPathSolver{}
+	err = solver.Initialize(0, edges, source, ShortestPathOptions{})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	// validating the inferred number of nodes
+	if solver.graph.n != 5 {
+		t.Errorf("The inferred number of nodes does not match the one expected.\nActual: %d\nExpected: %d", solver.graph.n, 5)
+	}
+
+	// validating solution
+	err = solver.Solve()
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	result := solver.FormatResult()
+
+	data, err := json.Marshal(result.Solution[2])
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	expectedJSON := `{"node":"store-1","distance":7,"reachable":true,"path":["warehouse-A","hub","store-1"],"steps":[{"from":"warehouse-A","to":"hub","weight":4,"reversed":false},{"from":"hub","to":"store-1","weight":3,"reversed":false}]}`
+	if string(data) != expectedJSON {
+		t.Errorf("The serialized node does not match the one expected.\nActual: %s\nExpected: %s", data, expectedJSON)
+	}
+
+	// validating that the nodes beyond the named ones are reported by their index
+	err = solver.Initialize(7, edges, source, ShortestPathOptions{})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	err = solver.Solve()
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+	unnamed := solver.FormatResult().Solution[6]
+	data, err = json.Marshal(unnamed.Node)
+	if err != nil || string(data) != "6" || unnamed.Distance != -1 {
+		t.Errorf("Expected the last node to be reported as 6 and to be unreachable, got %s at a distance of %v.", data, unnamed.Distance)
+	}
+
+	err = solver.Initialize(3, edges, source, ShortestPathOptions{})
+	if err == nil {
+		t.Errorf("Expected fewer nodes than distinct node names to be rejected.")
+	}
+
+	// validating that nodes have to be given in a consistent format
+	err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{})
+	if err == nil {
+		t.Errorf("Expected an integer source node to be rejected for a graph with named nodes.")
+	}
+
+	err = solver.Initialize(0, edges, NodeID{name: "warehouse-C", named: true}, ShortestPathOptions{})
+	if err == nil {
+		t.Errorf("Expected an unknown source node to be rejected.")
+	}
+
+	edges = append(edges, GraphEdge{From: indexNode(0), To: indexNode(1), Weight: 1})
+	err = solver.Initialize(0, edges, source, ShortestPathOptions{})
+	if err == nil {
+		t.Errorf("Expected a graph mixing integer and string nodes to be rejected.")
+	}
+
+	// print solution to help with debugging
+	fmt.Printf("%s\n", result.FormattedOutput)
+}
+
+func TestShortestPathInfersNodeCount(t *testing.T) {
+	solver := ShortestPathSolver{}
+	err := solver.Initialize(0, toGraphEdges([][3]int{{0, 3, 1}, {3, 1, 2}}), indexNode(0), ShortestPathOptions{})
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	if solver.graph.n != 4 {
+		t.Errorf("The inferred number of nodes does not match the one expected.\nActual: %d\nExpected: %d", solver.graph.n, 4)
+	}
+}
+
+func TestShortestPathRejectsNegativeWeights(t *testing.T) {
+	solver := ShortestPathSolver{}
+	err := solver.Initialize(3, toGraphEdges([][3]int{{0, 1, 2}, {1, 2, -1}}), indexNode(0), ShortestPathOptions{})
+	if err == nil {
+		t.Errorf("Expected Dijkstra's algorithm to reject negative weights.")
+	}
+}
+
+func TestShortestPathPrecision(t *testing.T) {
+	type Test struct {
+		edges                 string
+		precision             string
+		expectedDistance      float64
+		expectedExactDistance string
+	}
+
+	tests := []Test{
+		{
+			edges:            `[[0, 1, 0.1], [1, 2, 0.2], [0, 2, 0.5]]`,
+			precision:        FloatPrecision,
+			expectedDistance: 0.30000000000000004,
+		},
+		{
+			edges:                 `[[0, 1, 0.1], [1, 2, 0.2], [0, 2, 0.5]]`,
+			precision:             BigPrecision,
+			expectedDistance:      0.3,
+			expectedExactDistance: "0.3",
+		},
+		{
+			edges:                 `[[0, 1, 9007199254740993], [1, 2, 9007199254740993], [0, 2, 18014398509481987]]`,
+			precision:             BigPrecision,
+			expectedDistance:      18014398509481986,
+			expectedExactDistance: "18014398509481986",
+		},
+		{
+			edges:                 `[[0, 1, 1e-30], [1, 2, 1e30], [0, 2, 1000000000000000000000000000000.000000000000000000000000000002]]`,
+			precision:             BigPrecision,
+			expectedDistance:      1e30,
+			expectedExactDistance: "1000000000000000000000000000000.000000000000000000000000000001",
+		},
+		{
+			edges:                 `[[0, 1, 1e400], [1, 2, 1e400], [0, 2, 3e400]]`,
+			precision:             BigPrecision,
+			expectedDistance:      math.MaxFloat64,
+			expectedExactDistance: "2" + strings.Repeat("0", 400),
+		},
+	}
+
+	for testCount, test := range tests {
+		var edges []GraphEdge
+		err := json.Unmarshal([]byte(test.edges), &edges)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+
+		solver := ShortestPathSolver{}
+		target := indexNode(2)
+		err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{Target: &target, Precision: test.precision})
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		if result.Solution[0].Distance != test.expectedDistance {
+			t.Errorf("[%s] The actual distance does not match the one expected.\nActual: %v\nExpected: %v", test.precision, result.Solution[0].Distance, test.expectedDistance)
+		}
+		if result.Solution[0].ExactDistance != test.expectedExactDistance {
+			t.Errorf("[%s] The exact distance does not match the one expected.\nActual: %s\nExpected: %s", test.precision, result.Solution[0].ExactDistance, test.expectedExactDistance)
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+}
+
+func TestShortestPathOverflow(t *testing.T) {
+	for _, input := range []string{
+		`[[0, 1, 9007199254740993]]`,
+		`[[0, 1, 1e400]]`,
+	} {
+		var edges []GraphEdge
+		err := json.Unmarshal([]byte(input), &edges)
+		if err == nil {
+			solver := ShortestPathSolver{}
+			err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{})
+		}
+		if err == nil {
+			t.Errorf("Expected edges %s to be rejected.", input)
+		}
+	}
+
+	for _, input := range []string{
+		`[[0, 1, 4503599627370496], [1, 2, 4503599627370496], [2, 3, 4503599627370496]]`,
+		`[[0, 1, 1e308], [1, 2, 1e308], [2, 3, 0.5]]`,
+	} {
+		var edges []GraphEdge
+		err := json.Unmarshal([]byte(input), &edges)
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+
+		solver := ShortestPathSolver{}
+		err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{})
+		if err != nil {
+			t.Fatalf("%s", err)
+		}
+
+		err = solver.Solve()
+		if err == nil {
+			t.Errorf("Expected the distances for edges %s to overflow.", input)
+		}
+	}
+}
+
+func TestShortestPathMultiCriteria(t *testing.T) {
+	type Test struct {
+		mode            string
+		limits          []float64
+		expectedWeights [][]float64
+		expectedPaths   [][]int
+	}
+
+	var edges []GraphEdge
+	err := json.Unmarshal([]byte(`[
+		[0, 1, [1, 10]], [1, 3, [1, 10]],
+		[0, 2, [5, 2]], [2, 3, [5, 2]],
+		[0, 3, [6, 9]], [1, 2, [1, 1]]
+	]`), &edges)
+	if err != nil {
+		t.Fatalf("%s", err)
+	}
+
+	tests := []Test{
+		{
+			mode:            ParetoMode,
+			expectedWeights: [][]float64{{2, 20}, {6, 9}, {10, 4}},
+			expectedPaths:   [][]int{{0, 1, 3}, {0, 3}, {0, 2, 3}},
+		},
+		{
+			mode:            ParetoMode,
+			limits:          []float64{15},
+			expectedWeights: [][]float64{{6, 9}, {10, 4}},
+			expectedPaths:   [][]int{{0, 3}, {0, 2, 3}},
+		},
+		{
+			mode:            ConstrainedMode,
+			limits:          []float64{10},
+			expectedWeights: [][]float64{{6, 9}},
+			expectedPaths:   [][]int{{0, 3}},
+		},
+		{
+			mode:            ConstrainedMode,
+			limits:          []float64{5},
+			expectedWeights: [][]float64{{10, 4}},
+			expectedPaths:   [][]int{{0, 2, 3}},
+		},
+		{
+			mode:            ConstrainedMode,
+			limits:          []float64{3},
+			expectedWeights: [][]float64{},
+			expectedPaths:   [][]int{},
+		},
+	}
+
+	for testCount, test := range tests {
+		solver := ShortestPathSolver{}
+		target := indexNode(3)
+		err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{Target: &target, Mode: test.mode, Limits: test.limits})
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		err = solver.Solve()
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+		result := solver.FormatResult()
+
+		if len(solver.paths) != len(test.expectedPaths) {
+			t.Errorf("[%s] The number of paths does not match the one expected.\nActual: %d\nExpected: %d", test.mode, len(solver.paths), len(test.expectedPaths))
+			continue
+		}
+
+		for i, path := range solver.paths {
+			if !validateArray(path.weights, test.expectedWeights[i]) {
+				t.Errorf("[%s, Path %d] The weights do not match the ones expected.\nActual: %v\nExpected: %v", test.mode, i+1, path.weights, test.expectedWeights[i])
+			}
+			if !validateArray(path.nodes, test.expectedPaths[i]) {
+				t.Errorf("[%s, Path %d] The path does not match the one expected.\nActual: %v\nExpected: %v", test.mode, i+1, path.nodes, test.expectedPaths[i])
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that limits must match the number of weights
+	solver := ShortestPathSolver{}
+	target := indexNode(3)
+	err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{Target: &target, Mode: ConstrainedMode, Limits: []float64{10, 5}})
+	if err == nil {
+		t.Errorf("Expected limits for more weights than the edges have to be rejected.")
+	}
+
+	edges = append(edges, GraphEdge{From: indexNode(3), To: indexNode(0), Weight: 1})
+	err = solver.Initialize(0, edges, indexNode(0), ShortestPathOptions{Target: &target, Mode: ParetoMode})
+	if err == nil {
+		t.Errorf("Expected edges with different numbers of weights to be rejected.")
+	}
+}
+
+// Converts edges given in a [start, end, weight] format into directed graph edges
+func toGraphEdges(groups [][3]int) []GraphEdge {
+	edges := make([]GraphEdge, len(groups))
+	for i, group := range groups {
+		edges[i] = GraphEdge{From: indexNode(group[0]), To: indexNode(group[1]), Weight: float64(group[2])}
+	}
+
+	return edges
+}
+
+func indexNode(index int) NodeID {
+	return NodeID{index: index}
+}
+
+func toNodeIDs(nodes []int) []NodeID {
+	ids := make([]NodeID, len(nodes))
+	for i, node := range nodes {
+		ids[i] = indexNode(node)
+	}
+
+	return ids
+}
+
+// Checks that two closed cycles contain the same nodes in the same order, regardless of the starting node
+func validateCycle[T comparable](actualCycle, expectedCycle []T) bool {
+	if len(actualCycle) != len(expectedCycle) || len(actualCycle) == 0 {
+		return false
+	}
+
+	length := len(expectedCycle) - 1
+	for offset := range length {
+		matches := true
+		for i := range length {
+			if actualCycle[(i+offset)%length] != expectedCycle[i] {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks that two lists of edges are equal, comparing the weight vectors of each edge element by element
+func validateEdges[T any](actualEdges, expectedEdges []T) bool {
+	return slices.EqualFunc(actualEdges, expectedEdges, func(actual T, expected T) bool {
+		return reflect.DeepEqual(actual, expected)
+	})
+}
+
 func validateArray[T comparable](actualArray, expectedArray []T) bool {
 	if len(actualArray) != len(expectedArray) {
 		return false
@@ -0,0 +1,256 @@
+package solvers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Limits the number of iterations of the search, which is also spent on looking for a second solution,
+// as well as the size of the boxes, since every iteration copies the domains of all the cells
+const (
+	maxSudokuIterations = 100000
+	maxSudokuBox        = 4
+)
+
+// Represents a Sudoku grid of size n^2 x n^2, where every cell is a variable whose domain holds the digits
+// that none of its peers (the cells in the same row, column or box) use; the peers are shared between copies
+type sudokuGrid struct {
+	size    int
+	box     int
+	values  []int
+	domains []cspDomain
+	peers   [][]int
+}
+
+func (g *sudokuGrid) initialize(grid [][]int) error {
+	g.size = len(grid)
+	g.box = int(math.Round(math.Sqrt(float64(g.size))))
+	if g.size == 0 || g.box*g.box != g.size {
+		return fmt.Errorf("Grid has %d rows, but its size must be a positive perfect square (such as 4, 9 or 16).", g.size)
+	}
+	if g.box > maxSudokuBox {
+		return fmt.Errorf("Grid has %d rows, but grids can have at most %d rows.", g.size, maxSudokuBox*maxSudokuBox)
+	}
+
+	for row := range g.size {
+		if len(grid[row]) != g.size {
+			return fmt.Errorf("Row %d of the grid has %d values, but the grid has %d rows. The grid must be square.", row, len(grid[row]), g.size)
+		}
+	}
+
+	cells := g.size * g.size
+	g.values = make([]int, cells)
+	g.domains = make([]cspDomain, cells)
+	g.peers = make([][]int, cells)
+
+	for cell := range cells {
+		row, col := cell/g.size, cell%g.size
+
+		// gathering the cells of the same row, column and box, where the box shares some of them with the row and column
+		peers := make(map[int]bool, 3*g.size)
+		for i := range g.size {
+			boxRow, boxCol := row/g.box*g.box+i/g.box, col/g.box*g.box+i%g.box
+			peers[row*g.size+i] = true
+			peers[i*g.size+col] = true
+			peers[boxRow*g.size+boxCol] = true
+		}
+		delete(peers, cell)
+		g.peers[cell] = slices.Sorted(maps.Keys(peers))
+
+		g.values[cell] = -1
+		g.domains[cell] = make(cspDomain, g.size)
+		for digit := 1; digit <= g.size; digit++ {
+			g.domains[cell][digit] = true
+		}
+	}
+
+	// placing the given digits, which must not conflict with each other
+	for cell := range cells {
+		row, col := cell/g.size, cell%g.size
+		digit := grid[row][col]
+		if digit == 0 {
+			continue
+		}
+		if digit < 0 || digit > g.size {
+			return fmt.Errorf("Cell (%d, %d) contains %d. Digits belong to the interval [1, %d], or are 0 for empty cells.", row, col, digit, g.size)
+		}
+
+		for _, peer := range g.peers[cell] {
+			if peer < cell && g.values[peer] == digit {
+				return fmt.Errorf("Cells (%d, %d) and (%d, %d) both contain %d.", peer/g.size, peer%g.size, row, col, digit)
+			}
+		}
+
+		g.domains[cell] = cspDomain{digit: true}
+		g.assign(cell, digit)
+	}
+
+	return nil
+}
+
+func (g *sudokuGrid) variableCount() int {
+	return len(g.values)
+}
+
+func (g *sudokuGrid) isAssigned(cell int) bool {
+	return g.values[cell] != -1
+}
+
+func (g *sudokuGrid) domain(cell int) cspDomain {
+	return g.domains[cell]
+}
+
+func (g *sudokuGrid) assign(cell int, digit int) {
+	g.values[cell] = digit
+	for _, peer := range g.peers[cell] {
+		if g.values[peer] == -1 {
+			delete(g.domains[peer], digit)
+		}
+	}
+}
+
+func (g *sudokuGrid) cloneDeep() *sudokuGrid {
+	copy := sudokuGrid{
+		size:    g.size,
+		box:     g.box,
+		values:  make([]int, len(g.values)),
+		domains: make([]cspDomain, len(g.domains)),
+		peers:   g.peers,
+	}
+
+	for cell := range g.values {
+		copy.values[cell] = g.values[cell]
+		copy.domains[cell] = maps.Clone(g.domains[cell])
+	}
+
+	return &copy
+}
+
+func (g *sudokuGrid) value(cell int) int {
+	return g.values[cell]
+}
+
+func (g *sudokuGrid) constrained(cell int) []int {
+	return g.peers[cell]
+}
+
+func (g *sudokuGrid) compatible(cell int, digit int, peer int, peerDigit int) bool {
+	return digit != peerDigit
+}
+
+// Handles the problem solving logic
+type SudokuSolver struct {
+	grid       *sudokuGrid
+	givens     []bool
+	iterations int
+	solutions  int
+	exhausted  bool
+}
+
+func (s *SudokuSolver) Initialize(grid [][]int) error {
+	if len(grid) == 0 {
+		return errors.New("Grid must have at least one row.")
+	}
+
+	s.grid = &sudokuGrid{}
+	err := s.grid.initialize(grid)
+	if err != nil {
+		return err
+	}
+
+	s.givens = make([]bool, len(s.grid.values))
+	for cell := range s.givens {
+		s.givens[cell] = s.grid.isAssigned(cell)
+	}
+	s.iterations = 0
+	s.solutions = 0
+	s.exhausted = false
+
+	return nil
+}
+
+// Solves the grid with forward checking, MRV sorting and arc consistency, then keeps searching
+// for a second solution to find out whether the first one is unique
+func (s *SudokuSolver) Solve() {
+	search := newForwardChecking(s.grid, maxSudokuIterations)
+	search.maxSolutions = 2
+	search.run()
+
+	s.iterations = search.iterations
+	s.solutions = len(search.solutions)
+	s.exhausted = search.exhausted
+	if search.solvable {
+		s.grid = search.current
+	}
+}
+
+func (s *SudokuSolver) FormatResult() SudokuResult {
+	result := SudokuResult{}
+
+	result.Iterations = s.iterations
+	result.Unique = s.solutions == 1 && !s.exhausted
+	result.FormattedOutput = ""
+
+	if s.solutions == 0 {
+		result.Message = "No solution"
+		if s.exhausted {
+			result.Message = fmt.Sprintf("No solution found within %d iterations", maxSudokuIterations)
+		}
+		return result
+	}
+
+	switch {
+	case result.Unique:
+		result.Message = "Unique solution found"
+	case s.solutions > 1:
+		result.Message = "Solution found, but it is not unique"
+	default:
+		result.Message = fmt.Sprintf("Solution found, but its uniqueness could not be checked within %d iterations", maxSudokuIterations)
+	}
+
+	size, box := s.grid.size, s.grid.box
+	width := len(fmt.Sprint(size))
+	separators := make([]string, box)
+	for i := range separators {
+		separators[i] = strings.Repeat("-", box*(width+2)-1)
+	}
+
+	result.Solution = make([][]int, size)
+	for row := range size {
+		result.Solution[row] = s.grid.values[row*size : (row+1)*size]
+
+		// separating the boxes with lines, and marking the given digits with an asterisk
+		if row > 0 && row%box == 0 {
+			result.FormattedOutput += strings.Join(separators, "-+-") + "\n"
+		}
+
+		boxes := make([]string, box)
+		for col, digit := range result.Solution[row] {
+			marker := " "
+			if s.givens[row*size+col] {
+				marker = "*"
+			}
+
+			if col%box > 0 {
+				boxes[col/box] += " "
+			}
+			boxes[col/box] += fmt.Sprintf("%*d%s", width, digit, marker)
+		}
+		result.FormattedOutput += strings.TrimRight(strings.Join(boxes, " | "), " ") + "\n"
+	}
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type SudokuResult struct {
+	Message         string  `json:"message"`
+	Unique          bool    `json:"unique"`
+	Iterations      int     `json:"iterations"`
+	Solution        [][]int `json:"solution"`
+	FormattedOutput string  `json:"formatted_output"`
+}
@@ -0,0 +1,126 @@
+package solvers
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSudoku(t *testing.T) {
+	type Test struct {
+		grid             [][]int
+		expectedSolvable bool
+		expectedUnique   bool
+	}
+
+	tests := []Test{
+		{
+			grid: [][]int{
+				{5, 3, 0, 0, 7, 0, 0, 0, 0},
+				{6, 0, 0, 1, 9, 5, 0, 0, 0},
+				{0, 9, 8, 0, 0, 0, 0, 6, 0},
+				{8, 0, 0, 0, 6, 0, 0, 0, 3},
+				{4, 0, 0, 8, 0, 3, 0, 0, 1},
+				{7, 0, 0, 0, 2, 0, 0, 0, 6},
+				{0, 6, 0, 0, 0, 0, 2, 8, 0},
+				{0, 0, 0, 4, 1, 9, 0, 0, 5},
+				{0, 0, 0, 0, 8, 0, 0, 7, 9},
+			},
+			expectedSolvable: true,
+			expectedUnique:   true,
+		},
+		{
+			grid: [][]int{
+				{5, 3, 0, 0, 7, 0, 0, 0, 0},
+				{6, 0, 0, 1, 9, 5, 0, 0, 0},
+				{0, 9, 8, 0, 0, 0, 0, 6, 0},
+				{8, 0, 0, 0, 6, 0, 0, 0, 3},
+				{4, 0, 0, 8, 0, 3, 0, 0, 1},
+				{7, 0, 0, 0, 2, 0, 0, 0, 6},
+				{0, 6, 0, 0, 0, 0, 2, 8, 0},
+				{0, 0, 0, 0, 0, 0, 0, 0, 0},
+				{0, 0, 0, 0, 0, 0, 0, 0, 0},
+			},
+			expectedSolvable: true,
+			expectedUnique:   false,
+		},
+		{
+			grid: [][]int{
+				{1, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			expectedSolvable: true,
+			expectedUnique:   false,
+		},
+		{
+			grid: [][]int{
+				{1, 2, 0, 0},
+				{0, 0, 3, 0},
+				{0, 0, 0, 0},
+				{0, 0, 0, 0},
+			},
+			expectedSolvable: false,
+			expectedUnique:   false,
+		},
+	}
+
+	for testCount, test := range tests {
+		solver := SudokuSolver{}
+		err := solver.Initialize(test.grid)
+
+		// validating input data
+		if err != nil {
+			t.Errorf("%s", err)
+			continue
+		}
+
+		// validating solution
+		solver.Solve()
+		result := solver.FormatResult()
+
+		if (result.Solution != nil) != test.expectedSolvable {
+			t.Errorf("[Test %d] The solvability does not match the one expected.\nActual: %v\nExpected: %v", testCount+1, result.Solution != nil, test.expectedSolvable)
+		}
+		if result.Unique != test.expectedUnique {
+			t.Errorf("[Test %d] The uniqueness does not match the one expected.\nActual: %v\nExpected: %v", testCount+1, result.Unique, test.expectedUnique)
+		}
+
+		// validating that the solution keeps the given digits and that no peers share a digit
+		if result.Solution != nil {
+			size := len(test.grid)
+			for cell, peers := range solver.grid.peers {
+				row, col := cell/size, cell%size
+				if test.grid[row][col] != 0 && result.Solution[row][col] != test.grid[row][col] {
+					t.Errorf("[Test %d] The given digit of cell (%d, %d) was changed.", testCount+1, row, col)
+				}
+				for _, peer := range peers {
+					if solver.grid.values[peer] == solver.grid.values[cell] {
+						t.Errorf("[Test %d] Cells (%d, %d) and (%d, %d) contain the same digit.", testCount+1, row, col, peer/size, peer%size)
+					}
+				}
+			}
+		}
+
+		// print solution to help with debugging
+		fmt.Printf("------------------- Test %d -------------------\n", testCount+1)
+		fmt.Printf("%s\n", result.FormattedOutput)
+	}
+
+	// validating that conflicting digits are rejected
+	solver := SudokuSolver{}
+	err := solver.Initialize([][]int{{1, 1, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}})
+	if err == nil {
+		t.Errorf("Expected conflicting digits to be rejected.")
+	}
+
+	// validating that grids larger than 16x16 are rejected
+	grid := make([][]int, 25)
+	for row := range grid {
+		grid[row] = make([]int, 25)
+	}
+	err = solver.Initialize(grid)
+	if err == nil {
+		t.Errorf("Expected a 25x25 grid to be rejected.")
+	}
+}
@@ -0,0 +1,453 @@
+package solvers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Tiers that can produce the tour of a Travelling Salesman problem instance
+const (
+	ExactTier     = "exact"
+	HeuristicTier = "heuristic"
+)
+
+// The largest instance solved exactly by default, since the Held-Karp algorithm needs
+// O(2^n * n) memory and O(2^n * n^2) time
+const maxHeldKarpNodes = 16
+
+// Limits the work spent on improving the lower bound of heuristic tours, which costs O(n^2) per iteration
+const (
+	maxBoundIterations = 100
+	boundBudget        = 200000000
+)
+
+// Improvements smaller than this amount are ignored, so that rounding errors can not keep the local search running
+const tourEpsilon = 1e-9
+
+// Handles the problem solving logic
+type TSPSolver struct {
+	distances  [][]float64
+	n          int
+	tier       string
+	symmetric  bool
+	tour       []int
+	cost       float64
+	lowerBound float64
+}
+
+// Prepares a tour over the given distance matrix, which can be asymmetric; the diagonal is ignored
+func (s *TSPSolver) InitializeMatrix(distances [][]float64, tier string) error {
+	n := len(distances)
+	if n == 0 {
+		return errors.New("Distance matrix must have at least one row.")
+	}
+
+	s.symmetric = true
+	for i := range n {
+		if len(distances[i]) != n {
+			return fmt.Errorf("Row %d of the distance matrix has %d values, but the matrix has %d rows. The matrix must be square.", i, len(distances[i]), n)
+		}
+
+		for j := range n {
+			if i != j && distances[i][j] < 0 {
+				return fmt.Errorf("Distance from %d to %d is negative: %s. Distances must not be negative.", i, j, formatWeight(distances[i][j]))
+			}
+		}
+	}
+	for i := range n {
+		for j := range i {
+			if distances[i][j] != distances[j][i] {
+				s.symmetric = false
+			}
+		}
+	}
+
+	// the matrix is copied, so that the ignored diagonal can be cleared
+	s.distances = make([][]float64, n)
+	for i := range n {
+		s.distances[i] = slices.Clone(distances[i])
+		s.distances[i][i] = 0
+	}
+
+	return s.initializeTier(n, tier)
+}
+
+// Prepares a tour over the given points, using the Euclidean distances between them
+func (s *TSPSolver) InitializeCoordinates(coordinates [][2]float64, tier string) error {
+	n := len(coordinates)
+	if n == 0 {
+		return errors.New("At least one point must be given.")
+	}
+
+	s.distances = make([][]float64, n)
+	for i := range n {
+		s.distances[i] = make([]float64, n)
+		for j := range n {
+			s.distances[i][j] = math.Hypot(coordinates[i][0]-coordinates[j][0], coordinates[i][1]-coordinates[j][1])
+		}
+	}
+
+	s.symmetric = true
+	return s.initializeTier(n, tier)
+}
+
+func (s *TSPSolver) initializeTier(n int, tier string) error {
+	s.n = n
+	s.tier = tier
+	if s.tier == "" {
+		s.tier = HeuristicTier
+		if n <= maxHeldKarpNodes {
+			s.tier = ExactTier
+		}
+	}
+
+	if s.tier != ExactTier && s.tier != HeuristicTier {
+		return fmt.Errorf("Unknown tier \"%s\". Supported tiers are \"%s\" and \"%s\".", s.tier, ExactTier, HeuristicTier)
+	}
+	if s.tier == ExactTier && n > maxHeldKarpNodes {
+		return fmt.Errorf("The \"%s\" tier supports at most %d nodes, got %d. Use the \"%s\" tier for larger instances.", ExactTier, maxHeldKarpNodes, n, HeuristicTier)
+	}
+
+	s.tour = nil
+	s.cost = 0
+	s.lowerBound = 0
+
+	return nil
+}
+
+func (s *TSPSolver) Solve() error {
+	if s.tier == ExactTier {
+		s.heldKarp()
+		s.lowerBound = s.cost
+	} else {
+		s.nearestNeighbor()
+		s.improve()
+		s.lowerBound = min(s.cost, s.oneTreeBound())
+	}
+
+	if math.IsInf(s.cost, 0) {
+		return errors.New("Tour cost exceeds the range of floating-point numbers.")
+	}
+
+	return nil
+}
+
+// Computes the cheapest tour with the Held-Karp algorithm, where every state is a set of visited nodes
+// (all of them reached from node 0) along with the node the path currently ends in
+func (s *TSPSolver) heldKarp() {
+	n := s.n
+	if n == 1 {
+		s.tour = []int{0, 0}
+		return
+	}
+
+	// node 0 is always the start, so the sets only contain the other n - 1 nodes
+	size := 1 << (n - 1)
+	costs := make([][]float64, size)
+	previous := make([][]int, size)
+	for mask := range size {
+		costs[mask] = make([]float64, n-1)
+		previous[mask] = make([]int, n-1)
+		for i := range n - 1 {
+			costs[mask][i] = math.Inf(1)
+		}
+	}
+	for i := range n - 1 {
+		costs[1<<i][i] = s.distances[0][i+1]
+		previous[1<<i][i] = -1
+	}
+
+	for mask := 1; mask < size; mask++ {
+		for last := range n - 1 {
+			if mask&(1<<last) == 0 || math.IsInf(costs[mask][last], 1) {
+				continue
+			}
+
+			for next := range n - 1 {
+				if mask&(1<<next) != 0 {
+					continue
+				}
+
+				extended := mask | 1<<next
+				cost := costs[mask][last] + s.distances[last+1][next+1]
+				if cost < costs[extended][next] {
+					costs[extended][next] = cost
+					previous[extended][next] = last
+				}
+			}
+		}
+	}
+
+	// closing the tour at the node that leads back to the start most cheaply
+	full := size - 1
+	last := 0
+	s.cost = math.Inf(1)
+	for i := range n - 1 {
+		cost := costs[full][i] + s.distances[i+1][0]
+		if cost < s.cost {
+			s.cost = cost
+			last = i
+		}
+	}
+
+	tour := []int{0}
+	for mask := full; last != -1; {
+		tour = append(tour, last+1)
+		mask, last = mask^(1<<last), previous[mask][last]
+	}
+	tour = append(tour, 0)
+	slices.Reverse(tour)
+	s.tour = tour
+}
+
+// Builds a tour starting from node 0 that always moves to the closest unvisited node
+func (s *TSPSolver) nearestNeighbor() {
+	visited := make([]bool, s.n)
+	visited[0] = true
+	s.tour = []int{0}
+
+	for len(s.tour) < s.n {
+		current := s.tour[len(s.tour)-1]
+		next := -1
+		for node := range s.n {
+			if !visited[node] && (next == -1 || s.distances[current][node] < s.distances[current][next]) {
+				next = node
+			}
+		}
+
+		visited[next] = true
+		s.tour = append(s.tour, next)
+	}
+
+	s.tour = append(s.tour, 0)
+	s.cost = s.tourCost()
+}
+
+// Improves the tour with 2-opt and Or-opt moves until neither of them finds an improvement; 2-opt is only used
+// for symmetric distances, since reversing a segment changes its cost otherwise
+func (s *TSPSolver) improve() {
+	for {
+		improved := s.orOpt()
+		if s.symmetric && s.twoOpt() {
+			improved = true
+		}
+
+		if !improved {
+			break
+		}
+	}
+
+	s.cost = s.tourCost()
+}
+
+// Replaces pairs of edges [a, b] and [c, d] with [a, c] and [b, d], reversing the segment between them
+func (s *TSPSolver) twoOpt() bool {
+	improved := false
+	d := s.distances
+	for i := 0; i < s.n-1; i++ {
+		for j := i + 2; j < s.n; j++ {
+			a, b := s.tour[i], s.tour[i+1]
+			c, e := s.tour[j], s.tour[j+1]
+			if a == e {
+				continue
+			}
+
+			delta := d[a][c] + d[b][e] - d[a][b] - d[c][e]
+			if delta < -tourEpsilon {
+				slices.Reverse(s.tour[i+1 : j+1])
+				improved = true
+			}
+		}
+	}
+
+	return improved
+}
+
+// Moves segments of up to three consecutive nodes to a cheaper position in the tour, keeping their direction
+func (s *TSPSolver) orOpt() bool {
+	improved := false
+	d := s.distances
+	for length := 1; length <= 3; length++ {
+		for i := 1; i+length < s.n+1; i++ {
+			// the segment tour[i..j] is removed from between prev and next
+			j := i + length - 1
+			prev, next := s.tour[i-1], s.tour[j+1]
+			first, last := s.tour[i], s.tour[j]
+			removed := d[prev][first] + d[last][next] - d[prev][next]
+
+			for k := 0; k < s.n; k++ {
+				if k >= i-1 && k <= j {
+					continue
+				}
+
+				a, b := s.tour[k], s.tour[k+1]
+				delta := d[a][first] + d[last][b] - d[a][b] - removed
+				if delta >= -tourEpsilon {
+					continue
+				}
+
+				segment := slices.Clone(s.tour[i : j+1])
+				rest := slices.Delete(slices.Clone(s.tour), i, j+1)
+				position := k + 1
+				if k > j {
+					position -= length
+				}
+				s.tour = slices.Insert(rest, position, segment...)
+				improved = true
+				break
+			}
+		}
+	}
+
+	return improved
+}
+
+func (s *TSPSolver) tourCost() float64 {
+	cost := 0.0
+	for i := 0; i+1 < len(s.tour); i++ {
+		cost += s.distances[s.tour[i]][s.tour[i+1]]
+	}
+
+	return cost
+}
+
+// Computes the Held-Karp lower bound: a 1-tree (a spanning tree of nodes 1..n-1, plus the two cheapest edges
+// of node 0) is at most as expensive as any tour, and penalties on the nodes are adjusted with subgradient
+// optimization so that the tree gets closer to a tour; asymmetric distances are bounded by their symmetric minimum
+func (s *TSPSolver) oneTreeBound() float64 {
+	n := s.n
+	if n < 3 {
+		return s.cost
+	}
+
+	d := func(i int, j int) float64 {
+		return min(s.distances[i][j], s.distances[j][i])
+	}
+
+	penalties := make([]float64, n)
+	best := 0.0
+	scale := 2.0
+	stalled := 0
+	iterations := min(maxBoundIterations, max(1, boundBudget/(n*n)))
+
+	for range iterations {
+		degrees := make([]int, n)
+		total := 0.0
+
+		// Prim's algorithm over nodes 1..n-1, using the penalized distances
+		keys := make([]float64, n)
+		parents := make([]int, n)
+		inTree := make([]bool, n)
+		for i := 1; i < n; i++ {
+			keys[i] = math.Inf(1)
+		}
+		keys[1] = 0
+		parents[1] = -1
+		for range n - 1 {
+			node := -1
+			for i := 1; i < n; i++ {
+				if !inTree[i] && (node == -1 || keys[i] < keys[node]) {
+					node = i
+				}
+			}
+
+			inTree[node] = true
+			total += keys[node]
+			if parents[node] != -1 {
+				degrees[node]++
+				degrees[parents[node]]++
+			}
+
+			for i := 1; i < n; i++ {
+				cost := d(node, i) + penalties[node] + penalties[i]
+				if !inTree[i] && cost < keys[i] {
+					keys[i] = cost
+					parents[i] = node
+				}
+			}
+		}
+
+		// connecting node 0 through its two cheapest edges
+		first, second := -1, -1
+		for i := 1; i < n; i++ {
+			cost := d(0, i) + penalties[0] + penalties[i]
+			if first == -1 || cost < d(0, first)+penalties[0]+penalties[first] {
+				first, second = i, first
+			} else if second == -1 || cost < d(0, second)+penalties[0]+penalties[second] {
+				second = i
+			}
+		}
+		total += d(0, first) + d(0, second) + 2*penalties[0] + penalties[first] + penalties[second]
+		degrees[0] = 2
+		degrees[first]++
+		degrees[second]++
+
+		for _, penalty := range penalties {
+			total -= 2 * penalty
+		}
+
+		if total > best+tourEpsilon {
+			best = total
+			stalled = 0
+		} else if stalled++; stalled >= 5 {
+			scale /= 2
+			stalled = 0
+		}
+
+		// the tree is a tour when every node has two neighbors, in which case the bound can not improve
+		norm := 0.0
+		for _, degree := range degrees {
+			norm += float64((degree - 2) * (degree - 2))
+		}
+		if norm == 0 || s.cost-total <= tourEpsilon {
+			break
+		}
+
+		step := scale * (s.cost - total) / norm
+		for i := range n {
+			penalties[i] += step * float64(degrees[i]-2)
+		}
+	}
+
+	return best
+}
+
+func (s *TSPSolver) FormatResult() TSPResult {
+	result := TSPResult{}
+
+	result.Message = "Optimal tour found"
+	result.Tier = s.tier
+	result.Tour = s.tour
+	result.Cost = s.cost
+	result.LowerBound = s.lowerBound
+	result.FormattedOutput = ""
+
+	if s.lowerBound > 0 {
+		result.Gap = (s.cost - s.lowerBound) / s.lowerBound * 100
+	}
+	if result.Gap > tourEpsilon {
+		result.Message = "Tour found"
+	}
+
+	result.FormattedOutput += fmt.Sprintf("Tour computed with the %s tier: %v\n", s.tier, s.tour)
+	for i := 0; i+1 < len(s.tour); i++ {
+		result.FormattedOutput += fmt.Sprintf("%d -> %d: %s\n", s.tour[i], s.tour[i+1], formatWeight(s.distances[s.tour[i]][s.tour[i+1]]))
+	}
+	result.FormattedOutput += fmt.Sprintf("Cost: %s\n", formatWeight(s.cost))
+	result.FormattedOutput += fmt.Sprintf("Lower bound: %s (gap %.2f%%)\n", formatWeight(s.lowerBound), result.Gap)
+
+	return result
+}
+
+// Represents the final solution obtained after running the algorithm
+type TSPResult struct {
+	Message         string  `json:"message"`
+	Tier            string  `json:"tier"`
+	Tour            []int   `json:"tour"`
+	Cost            float64 `json:"cost"`
+	LowerBound      float64 `json:"lower_bound"`
+	Gap             float64 `json:"gap"`
+	FormattedOutput string  `json:"formatted_output"`
+}
@@ -0,0 +1,102 @@
+package solvers
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestTSP(t *testing.T) {
+	type Test struct {
+		distances    [][]float64
+		coordinates  [][2]float64
+		expectedCost float64
+	}
+
+	// points on a circle are visited in order by the optimal tour
+	circle := make([][2]float64, 14)
+	for i := range circle {
+		angle := float64((i*5)%14) * 2 * math.Pi / 14
+		circle[i] = [2]float64{math.Cos(angle), math.Sin(angle)}
+	}
+
+	tests := []Test{
+		{
+			distances:    [][]float64{{0, 10, 15, 20}, {10, 0, 35, 25}, {15, 35, 0, 30}, {20, 25, 30, 0}},
+			expectedCost: 80,
+		},
+		{
+			distances:    [][]float64{{0, 1, 9, 9}, {9, 0, 1, 9}, {9, 9, 0, 1}, {1, 9, 9, 0}},
+			expectedCost: 4,
+		},
+		{
+			coordinates:  [][2]float64{{0, 0}, {1, 1}, {1, 0}, {0, 1}},
+			expectedCost: 4,
+		},
+		{
+			coordinates:  circle,
+			expectedCost: 28 * math.Sin(math.Pi/14),
+		},
+		{
+			distances:    [][]float64{{0}},
+			expectedCost: 0,
+		},
+	}
+
+	for testCount, test := range tests {
+		for _, tier := range []string{ExactTier, HeuristicTier} {
+			solver := TSPSolver{}
+			var err error
+			if test.coordinates != nil {
+				err = solver.InitializeCoordinates(test.coordinates, tier)
+			} else {
+				err = solver.InitializeMatrix(test.distances, tier)
+			}
+
+			// validating input data
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+
+			// validating solution
+			err = solver.Solve()
+			if err != nil {
+				t.Errorf("%s", err)
+				continue
+			}
+			result := solver.FormatResult()
+
+			if math.Abs(result.Cost-test.expectedCost) > 1e-9 {
+				t.Errorf("[%s] The tour cost does not match the one expected.\nActual: %v\nExpected: %v", tier, result.Cost, test.expectedCost)
+			}
+			if result.LowerBound > test.expectedCost+1e-9 {
+				t.Errorf("[%s] The lower bound exceeds the cost of the optimal tour.\nActual: %v\nExpected: at most %v", tier, result.LowerBound, test.expectedCost)
+			}
+
+			// validating that the tour visits every node exactly once
+			n := solver.n
+			visited := make([]bool, n)
+			if len(result.Tour) != n+1 || result.Tour[0] != result.Tour[n] {
+				t.Errorf("[%s] The tour is not closed: %v", tier, result.Tour)
+			}
+			for _, node := range result.Tour[:n] {
+				if visited[node] {
+					t.Errorf("[%s] Node %d is visited more than once.", tier, node)
+				}
+				visited[node] = true
+			}
+
+			// print solution to help with debugging
+			fmt.Printf("------------------- Test %d (%s) -------------------\n", testCount+1, tier)
+			fmt.Printf("%s\n", result.FormattedOutput)
+		}
+	}
+
+	// validating that the exact tier rejects large instances
+	solver := TSPSolver{}
+	err := solver.InitializeCoordinates(make([][2]float64, maxHeldKarpNodes+1), ExactTier)
+	if err == nil {
+		t.Errorf("Expected the exact tier to reject instances with more than %d nodes.", maxHeldKarpNodes)
+	}
+}
@@ -7,7 +7,8 @@ import (
 )
 
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error   string `json:"error"`
+	Details any    `json:"details,omitempty"`
 }
 
 func RespondWithError(w http.ResponseWriter, code int, message string) {
@@ -16,6 +17,13 @@ func RespondWithError(w http.ResponseWriter, code int, message string) {
 	})
 }
 
+func RespondWithErrorDetails(w http.ResponseWriter, code int, message string, details any) {
+	RespondWithJSON(w, code, ErrorResponse{
+		Error:   message,
+		Details: details,
+	})
+}
+
 func RespondWithJSON(w http.ResponseWriter, code int, payload any) {
 	w.Header().Set("Content-Type", "application/json")
 
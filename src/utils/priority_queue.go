@@ -1,35 +1,37 @@
 package utils
 
-type Item[T any] struct {
+import "cmp"
+
+type Item[T any, P cmp.Ordered] struct {
 	Value    T
-	Priority int
+	Priority P
 	index    int
 }
 
-type PriorityQueue[T any] []*Item[T]
+type PriorityQueue[T any, P cmp.Ordered] []*Item[T, P]
 
-func (pq PriorityQueue[T]) Len() int {
+func (pq PriorityQueue[T, P]) Len() int {
 	return len(pq)
 }
 
-func (pq PriorityQueue[T]) Less(i, j int) bool {
+func (pq PriorityQueue[T, P]) Less(i, j int) bool {
 	return pq[i].Priority > pq[j].Priority
 }
 
-func (pq PriorityQueue[T]) Swap(i, j int) {
+func (pq PriorityQueue[T, P]) Swap(i, j int) {
 	pq[i], pq[j] = pq[j], pq[i]
 	pq[i].index = i
 	pq[j].index = j
 }
 
-func (pq *PriorityQueue[T]) Push(x any) {
+func (pq *PriorityQueue[T, P]) Push(x any) {
 	n := len(*pq)
-	item := x.(*Item[T])
+	item := x.(*Item[T, P])
 	item.index = n
 	*pq = append(*pq, item)
 }
 
-func (pq *PriorityQueue[T]) Pop() any {
+func (pq *PriorityQueue[T, P]) Pop() any {
 	old := *pq
 	n := len(old)
 	item := old[n-1]
@@ -0,0 +1,57 @@
+package utils
+
+// Represents a collection of disjoint sets over the elements in the interval [0, n)
+type UnionFind struct {
+	parent []int
+	size   []int
+	Count  int
+}
+
+func NewUnionFind(n int) *UnionFind {
+	uf := UnionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		Count:  n,
+	}
+
+	for i := range n {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+
+	return &uf
+}
+
+// Returns the representative of the set containing the given element, compressing the path towards it
+func (uf *UnionFind) Find(x int) int {
+	root := x
+	for uf.parent[root] != root {
+		root = uf.parent[root]
+	}
+
+	for x != root {
+		next := uf.parent[x]
+		uf.parent[x] = root
+		x = next
+	}
+
+	return root
+}
+
+// Merges the sets containing the two elements, attaching the smaller one to the larger one;
+// returns false if the elements were already in the same set
+func (uf *UnionFind) Union(x int, y int) bool {
+	x, y = uf.Find(x), uf.Find(y)
+	if x == y {
+		return false
+	}
+
+	if uf.size[x] < uf.size[y] {
+		x, y = y, x
+	}
+	uf.parent[y] = x
+	uf.size[x] += uf.size[y]
+	uf.Count--
+
+	return true
+}